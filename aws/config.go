@@ -1,14 +1,24 @@
 package aws
 
 import (
+	"crypto/tls"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
+	"net/url"
+	"os"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
@@ -141,6 +151,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
 	"github.com/aws/aws-sdk-go/service/servicequotas"
 	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/sesv2"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/shield"
 	"github.com/aws/aws-sdk-go/service/signer"
@@ -164,9 +175,27 @@ import (
 	"github.com/aws/aws-sdk-go/service/xray"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/ratelimit"
 )
 
+const (
+	RetryModeAdaptive = "adaptive"
+	RetryModeStandard = "standard"
+
+	EC2MetadataServiceEndpointModeIPv4 = "IPv4"
+	EC2MetadataServiceEndpointModeIPv6 = "IPv6"
+
+	ec2MetadataServiceEndpointIPv6 = "http://[fd00:ec2::254]"
+)
+
+// RateLimit is a client-side request rate limit for an AWS service.
+type RateLimit struct {
+	Burst             int
+	RequestsPerSecond float64
+}
+
 type Config struct {
 	AccessKey     string
 	SecretKey     string
@@ -176,6 +205,10 @@ type Config struct {
 	Region        string
 	MaxRetries    int
 
+	MaxBackoff time.Duration
+	RateLimits map[string]RateLimit
+	RetryMode  string
+
 	AssumeRoleARN               string
 	AssumeRoleDurationSeconds   int
 	AssumeRoleExternalID        string
@@ -185,13 +218,26 @@ type Config struct {
 	AssumeRoleTags              map[string]string
 	AssumeRoleTransitiveTagKeys []string
 
+	AssumeRoleWithWebIdentityDurationSeconds int
+	AssumeRoleWithWebIdentityPolicy          string
+	AssumeRoleWithWebIdentityPolicyARNs      []string
+	AssumeRoleWithWebIdentityRoleARN         string
+	AssumeRoleWithWebIdentitySessionName     string
+	AssumeRoleWithWebIdentityToken           string
+	AssumeRoleWithWebIdentityTokenFile       string
+
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
 
+	CustomCABundle   string
 	Endpoints        map[string]string
+	HTTPProxy        string
 	IgnoreTagsConfig *keyvaluetags.IgnoreConfig
 	Insecure         bool
 
+	EC2MetadataServiceEndpoint     string
+	EC2MetadataServiceEndpointMode string
+
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
 	SkipRegionValidation    bool
@@ -342,6 +388,7 @@ type AWSClient struct {
 	serverlessapplicationrepositoryconn *serverlessapplicationrepository.ServerlessApplicationRepository
 	servicequotasconn                   *servicequotas.ServiceQuotas
 	sesconn                             *ses.SES
+	sesv2conn                           *sesv2.SESV2
 	sfnconn                             *sfn.SFN
 	shieldconn                          *shield.Shield
 	signerconn                          *signer.Signer
@@ -424,11 +471,42 @@ func (c *Config) Client() (interface{}, error) {
 		},
 	}
 
+	// The custom CA bundle, HTTP proxy and EC2 metadata endpoint must also apply
+	// to the requests made by the aws-sdk-go-base credential chain, which only
+	// honors them via the standard environment variables.
+	if err := c.setNetworkEnvironment(); err != nil {
+		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+	}
+
+	// Federated credentials take precedence over every other source in the
+	// credential chain; any assume_role configuration is applied on top of them.
+	if c.AssumeRoleWithWebIdentityRoleARN != "" {
+		creds, err := c.assumeRoleWithWebIdentity()
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: error assuming IAM Role (%s) with web identity: %w", c.AssumeRoleWithWebIdentityRoleARN, err)
+		}
+
+		awsbaseConfig.AccessKey = aws.StringValue(creds.AccessKeyId)
+		awsbaseConfig.SecretKey = aws.StringValue(creds.SecretAccessKey)
+		awsbaseConfig.Token = aws.StringValue(creds.SessionToken)
+	}
+
 	sess, accountID, partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
 	}
 
+	if c.HTTPProxy != "" {
+		if transport, ok := sess.Config.HTTPClient.Transport.(*http.Transport); ok {
+			proxyURL, _ := url.Parse(c.HTTPProxy)
+			transport.Proxy = http.ProxyURL(proxyURL)
+		}
+	}
+
+	// Every service client below is created from a copy of the session and
+	// inherits its retryer and request handlers.
+	c.configureRetries(sess)
+
 	if accountID == "" {
 		log.Printf("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -577,6 +655,7 @@ func (c *Config) Client() (interface{}, error) {
 		serverlessapplicationrepositoryconn: serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["serverlessrepo"])})),
 		servicequotasconn:                   servicequotas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["servicequotas"])})),
 		sesconn:                             ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ses"])})),
+		sesv2conn:                           sesv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sesv2"])})),
 		sfnconn:                             sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["stepfunctions"])})),
 		signerconn:                          signer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["signer"])})),
 		simpledbconn:                        simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sdb"])})),
@@ -793,6 +872,214 @@ func (c *Config) Client() (interface{}, error) {
 	return client, nil
 }
 
+// configureRetries configures the session's retry backoff and the
+// client-side rate limiting of requests to each AWS service.
+func (c *Config) configureRetries(sess *session.Session) {
+	if c.MaxBackoff > 0 {
+		sess.Config.Retryer = client.DefaultRetryer{
+			NumMaxRetries:    c.MaxRetries,
+			MaxRetryDelay:    c.MaxBackoff,
+			MaxThrottleDelay: c.MaxBackoff,
+		}
+	}
+
+	adaptive := c.RetryMode == RetryModeAdaptive
+
+	if len(c.RateLimits) == 0 && !adaptive {
+		return
+	}
+
+	var lock sync.Mutex
+	limiters := make(map[string]*ratelimit.Limiter)
+
+	for service, rateLimit := range c.RateLimits {
+		if adaptive {
+			limiters[service] = ratelimit.NewAdaptive(rateLimit.RequestsPerSecond, rateLimit.Burst)
+		} else {
+			limiters[service] = ratelimit.New(rateLimit.RequestsPerSecond, rateLimit.Burst)
+		}
+	}
+
+	// Requests are identified by the service's endpoint prefix, e.g. "ec2".
+	limiter := func(r *request.Request) *ratelimit.Limiter {
+		service := r.ClientInfo.ServiceName
+
+		lock.Lock()
+		defer lock.Unlock()
+
+		l, ok := limiters[service]
+
+		if !ok && adaptive {
+			l = ratelimit.NewAdaptive(0, 0)
+			limiters[service] = l
+		}
+
+		return l
+	}
+
+	// Each attempt, including retries, waits for the rate limit before being signed.
+	sess.Handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.RateLimitHandler",
+		Fn: func(r *request.Request) {
+			// Presigned requests are not sent.
+			if r.ExpireTime > 0 {
+				return
+			}
+
+			l := limiter(r)
+			if l == nil {
+				return
+			}
+
+			if err := l.Wait(r.Context()); err != nil {
+				r.Error = awserr.New(request.CanceledErrorCode, "request rate limit wait canceled", err)
+			}
+		},
+	})
+
+	if !adaptive {
+		return
+	}
+
+	sess.Handlers.Retry.PushBack(func(r *request.Request) {
+		if request.IsErrorThrottle(r.Error) {
+			if l := limiter(r); l != nil {
+				l.Throttled()
+			}
+		}
+	})
+
+	sess.Handlers.Complete.PushBack(func(r *request.Request) {
+		if r.Error == nil {
+			if l := limiter(r); l != nil {
+				l.Succeeded()
+			}
+		}
+	})
+}
+
+// setNetworkEnvironment exports the provider-level network settings as the
+// environment variables read by the AWS SDK when creating sessions.
+func (c *Config) setNetworkEnvironment() error {
+	if c.CustomCABundle != "" {
+		if _, err := os.Stat(c.CustomCABundle); err != nil {
+			return fmt.Errorf("error reading custom CA bundle (%s): %w", c.CustomCABundle, err)
+		}
+
+		os.Setenv("AWS_CA_BUNDLE", c.CustomCABundle)
+	}
+
+	if c.HTTPProxy != "" {
+		if _, err := url.Parse(c.HTTPProxy); err != nil {
+			return fmt.Errorf("error parsing HTTP proxy URL (%s): %w", c.HTTPProxy, err)
+		}
+
+		os.Setenv("HTTP_PROXY", c.HTTPProxy)
+		os.Setenv("HTTPS_PROXY", c.HTTPProxy)
+	}
+
+	endpoint := c.EC2MetadataServiceEndpoint
+
+	switch c.EC2MetadataServiceEndpointMode {
+	case "", EC2MetadataServiceEndpointModeIPv4:
+	case EC2MetadataServiceEndpointModeIPv6:
+		if endpoint == "" {
+			endpoint = ec2MetadataServiceEndpointIPv6
+		}
+	default:
+		return fmt.Errorf("unsupported EC2 metadata service endpoint mode: %s", c.EC2MetadataServiceEndpointMode)
+	}
+
+	if endpoint != "" {
+		os.Setenv("AWS_EC2_METADATA_SERVICE_ENDPOINT", endpoint)
+
+		// aws-sdk-go-base reads the legacy variable, which includes the API version.
+		if os.Getenv("AWS_METADATA_URL") == "" {
+			os.Setenv("AWS_METADATA_URL", strings.TrimSuffix(endpoint, "/")+"/latest")
+		}
+	}
+
+	return nil
+}
+
+// assumeRoleWithWebIdentity exchanges the configured OpenID Connect token
+// for temporary IAM Role credentials.
+func (c *Config) assumeRoleWithWebIdentity() (*sts.Credentials, error) {
+	token := c.AssumeRoleWithWebIdentityToken
+
+	if c.AssumeRoleWithWebIdentityTokenFile != "" {
+		b, err := ioutil.ReadFile(c.AssumeRoleWithWebIdentityTokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading web identity token file (%s): %w", c.AssumeRoleWithWebIdentityTokenFile, err)
+		}
+
+		token = strings.TrimSpace(string(b))
+	}
+
+	if token == "" {
+		return nil, fmt.Errorf("one of web_identity_token or web_identity_token_file must be set")
+	}
+
+	// AssumeRoleWithWebIdentity is an unsigned request.
+	awsConfig := &aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		MaxRetries:  aws.Int(c.MaxRetries),
+		Region:      aws.String(c.Region),
+	}
+
+	if v := c.Endpoints["sts"]; v != "" {
+		awsConfig.Endpoint = aws.String(v)
+	}
+
+	if c.Insecure {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+		awsConfig.HTTPClient = &http.Client{Transport: transport}
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionName := c.AssumeRoleWithWebIdentitySessionName
+	if sessionName == "" {
+		sessionName = resource.PrefixedUniqueId("terraform-")
+	}
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String(c.AssumeRoleWithWebIdentityRoleARN),
+		RoleSessionName:  aws.String(sessionName),
+		WebIdentityToken: aws.String(token),
+	}
+
+	if c.AssumeRoleWithWebIdentityDurationSeconds != 0 {
+		input.DurationSeconds = aws.Int64(int64(c.AssumeRoleWithWebIdentityDurationSeconds))
+	}
+
+	if c.AssumeRoleWithWebIdentityPolicy != "" {
+		input.Policy = aws.String(c.AssumeRoleWithWebIdentityPolicy)
+	}
+
+	for _, policyARN := range c.AssumeRoleWithWebIdentityPolicyARNs {
+		input.PolicyArns = append(input.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	log.Printf("[INFO] Attempting to AssumeRoleWithWebIdentity (ARN: %q, SessionName: %q)", c.AssumeRoleWithWebIdentityRoleARN, sessionName)
+	output, err := sts.New(sess).AssumeRoleWithWebIdentity(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Credentials == nil {
+		return nil, fmt.Errorf("empty response")
+	}
+
+	return output.Credentials, nil
+}
+
 func hasEc2Classic(platforms []string) bool {
 	for _, p := range platforms {
 		if p == "EC2" {
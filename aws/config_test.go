@@ -1,9 +1,16 @@
 package aws
 
 import (
+	"io/ioutil"
+	"os"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 )
@@ -114,6 +121,100 @@ func TestGetSupportedEC2Platforms(t *testing.T) {
 	}
 }
 
+func TestConfigAssumeRoleWithWebIdentity(t *testing.T) {
+	tokenFile, err := ioutil.TempFile("", "tf-acc-test-web-identity-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tokenFile.Name())
+
+	if _, err := tokenFile.WriteString(awsbase.MockWebIdentityToken + "\n"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tokenFile.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name          string
+		Config        *Config
+		ExpectedError bool
+	}{
+		{
+			Name: "web_identity_token",
+			Config: &Config{
+				AssumeRoleWithWebIdentityRoleARN:     awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+				AssumeRoleWithWebIdentityToken:       awsbase.MockWebIdentityToken,
+			},
+		},
+		{
+			Name: "web_identity_token_file",
+			Config: &Config{
+				AssumeRoleWithWebIdentityRoleARN:     awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+				AssumeRoleWithWebIdentityTokenFile:   tokenFile.Name(),
+			},
+		},
+		{
+			Name: "no token",
+			Config: &Config{
+				AssumeRoleWithWebIdentityRoleARN:     awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "invalid token",
+			Config: &Config{
+				AssumeRoleWithWebIdentityRoleARN:     awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+				AssumeRoleWithWebIdentityToken:       "invalid",
+			},
+			ExpectedError: true,
+		},
+	}
+
+	ts := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+		awsbase.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+	})
+	defer ts.Close()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Config.Endpoints = map[string]string{"sts": ts.URL}
+			testCase.Config.Region = "us-east-1" //lintignore:AWSAT003
+
+			creds, err := testCase.Config.assumeRoleWithWebIdentity()
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := aws.StringValue(creds.AccessKeyId), awsbase.MockStsAssumeRoleWithWebIdentityAccessKey; got != expected {
+				t.Errorf("got access key %s, expected %s", got, expected)
+			}
+
+			if got, expected := aws.StringValue(creds.SecretAccessKey), awsbase.MockStsAssumeRoleWithWebIdentitySecretKey; got != expected {
+				t.Errorf("got secret key %s, expected %s", got, expected)
+			}
+
+			if got, expected := aws.StringValue(creds.SessionToken), awsbase.MockStsAssumeRoleWithWebIdentitySessionToken; got != expected {
+				t.Errorf("got session token %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
 var test_ec2_describeAccountAttributes_response = `<DescribeAccountAttributesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
   <requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId>
   <accountAttributeSet>
@@ -130,3 +231,165 @@ var test_ec2_describeAccountAttributes_response = `<DescribeAccountAttributesRes
     </item>
   </accountAttributeSet>
 </DescribeAccountAttributesResponse>`
+
+func TestConfigSetNetworkEnvironment(t *testing.T) {
+	testCases := []struct {
+		Name                               string
+		Config                             *Config
+		ExpectedEC2MetadataServiceEndpoint string
+		ExpectedError                      bool
+	}{
+		{
+			Name:                               "default",
+			Config:                             &Config{},
+			ExpectedEC2MetadataServiceEndpoint: "",
+		},
+		{
+			Name: "endpoint",
+			Config: &Config{
+				EC2MetadataServiceEndpoint: "http://127.0.0.1:8080",
+			},
+			ExpectedEC2MetadataServiceEndpoint: "http://127.0.0.1:8080",
+		},
+		{
+			Name: "endpoint mode IPv4",
+			Config: &Config{
+				EC2MetadataServiceEndpointMode: EC2MetadataServiceEndpointModeIPv4,
+			},
+			ExpectedEC2MetadataServiceEndpoint: "",
+		},
+		{
+			Name: "endpoint mode IPv6",
+			Config: &Config{
+				EC2MetadataServiceEndpointMode: EC2MetadataServiceEndpointModeIPv6,
+			},
+			ExpectedEC2MetadataServiceEndpoint: "http://[fd00:ec2::254]",
+		},
+		{
+			Name: "endpoint and endpoint mode IPv6",
+			Config: &Config{
+				EC2MetadataServiceEndpoint:     "http://[::1]",
+				EC2MetadataServiceEndpointMode: EC2MetadataServiceEndpointModeIPv6,
+			},
+			ExpectedEC2MetadataServiceEndpoint: "http://[::1]",
+		},
+		{
+			Name: "invalid endpoint mode",
+			Config: &Config{
+				EC2MetadataServiceEndpointMode: "IPv5",
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "missing custom CA bundle",
+			Config: &Config{
+				CustomCABundle: "test-fixtures/does-not-exist.pem",
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			defer os.Unsetenv("AWS_EC2_METADATA_SERVICE_ENDPOINT")
+			defer os.Unsetenv("AWS_METADATA_URL")
+
+			err := testCase.Config.setNetworkEnvironment()
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := os.Getenv("AWS_EC2_METADATA_SERVICE_ENDPOINT"), testCase.ExpectedEC2MetadataServiceEndpoint; got != expected {
+				t.Errorf("got EC2 metadata service endpoint %q, expected %q", got, expected)
+			}
+		})
+	}
+}
+
+func TestConfigConfigureRetries(t *testing.T) {
+	testCases := []struct {
+		Name                     string
+		Config                   *Config
+		ExpectedMaxRetryDelay    time.Duration
+		ExpectedRateLimitHandler bool
+	}{
+		{
+			Name:   "default",
+			Config: &Config{RetryMode: RetryModeStandard},
+		},
+		{
+			Name: "max backoff",
+			Config: &Config{
+				MaxBackoff: 30 * time.Second,
+				MaxRetries: 5,
+				RetryMode:  RetryModeStandard,
+			},
+			ExpectedMaxRetryDelay: 30 * time.Second,
+		},
+		{
+			Name: "rate limits",
+			Config: &Config{
+				RateLimits: map[string]RateLimit{
+					"ec2": {RequestsPerSecond: 20},
+				},
+				RetryMode: RetryModeStandard,
+			},
+			ExpectedRateLimitHandler: true,
+		},
+		{
+			Name:                     "adaptive",
+			Config:                   &Config{RetryMode: RetryModeAdaptive},
+			ExpectedRateLimitHandler: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			sess, err := session.NewSession(&aws.Config{
+				Credentials: credentials.AnonymousCredentials,
+				Region:      aws.String("us-east-1"), //lintignore:AWSAT003
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			signHandlers := sess.Handlers.Sign.Len()
+
+			testCase.Config.configureRetries(sess)
+
+			if testCase.ExpectedMaxRetryDelay == 0 {
+				if sess.Config.Retryer != nil {
+					t.Errorf("expected no retryer, got %#v", sess.Config.Retryer)
+				}
+			} else {
+				retryer, ok := sess.Config.Retryer.(client.DefaultRetryer)
+
+				if !ok {
+					t.Fatalf("expected client.DefaultRetryer, got %#v", sess.Config.Retryer)
+				}
+
+				if got, expected := retryer.MaxRetryDelay, testCase.ExpectedMaxRetryDelay; got != expected {
+					t.Errorf("got max retry delay %s, expected %s", got, expected)
+				}
+
+				if got, expected := retryer.NumMaxRetries, testCase.Config.MaxRetries; got != expected {
+					t.Errorf("got max retries %d, expected %d", got, expected)
+				}
+			}
+
+			if got, expected := sess.Handlers.Sign.Len() > signHandlers, testCase.ExpectedRateLimitHandler; got != expected {
+				t.Errorf("got rate limit handler %t, expected %t", got, expected)
+			}
+		})
+	}
+}
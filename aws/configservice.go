@@ -177,3 +177,280 @@ func configWaitForOrganizationRuleStatusUpdateSuccessful(conn *configservice.Con
 
 	return err
 }
+
+func configDescribeConformancePack(conn *configservice.ConfigService, name string) (*configservice.ConformancePackDetail, error) {
+	input := &configservice.DescribeConformancePacksInput{
+		ConformancePackNames: []*string{aws.String(name)},
+	}
+
+	for {
+		output, err := conn.DescribeConformancePacks(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pack := range output.ConformancePackDetails {
+			if aws.StringValue(pack.ConformancePackName) == name {
+				return pack, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+func configDescribeConformancePackStatus(conn *configservice.ConfigService, name string) (*configservice.ConformancePackStatusDetail, error) {
+	input := &configservice.DescribeConformancePackStatusInput{
+		ConformancePackNames: []*string{aws.String(name)},
+	}
+
+	for {
+		output, err := conn.DescribeConformancePackStatus(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, status := range output.ConformancePackStatusDetails {
+			if aws.StringValue(status.ConformancePackName) == name {
+				return status, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+func configDescribeOrganizationConformancePack(conn *configservice.ConfigService, name string) (*configservice.OrganizationConformancePack, error) {
+	input := &configservice.DescribeOrganizationConformancePacksInput{
+		OrganizationConformancePackNames: []*string{aws.String(name)},
+	}
+
+	for {
+		output, err := conn.DescribeOrganizationConformancePacks(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pack := range output.OrganizationConformancePacks {
+			if aws.StringValue(pack.OrganizationConformancePackName) == name {
+				return pack, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+func configDescribeOrganizationConformancePackStatus(conn *configservice.ConfigService, name string) (*configservice.OrganizationConformancePackStatus, error) {
+	input := &configservice.DescribeOrganizationConformancePackStatusesInput{
+		OrganizationConformancePackNames: []*string{aws.String(name)},
+	}
+
+	for {
+		output, err := conn.DescribeOrganizationConformancePackStatuses(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, status := range output.OrganizationConformancePackStatuses {
+			if aws.StringValue(status.OrganizationConformancePackName) == name {
+				return status, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+func configGetOrganizationConformancePackDetailedStatus(conn *configservice.ConfigService, name, status string) ([]*configservice.OrganizationConformancePackDetailedStatus, error) {
+	input := &configservice.GetOrganizationConformancePackDetailedStatusInput{
+		Filters: &configservice.OrganizationResourceDetailedStatusFilters{
+			Status: aws.String(status),
+		},
+		OrganizationConformancePackName: aws.String(name),
+	}
+	var statuses []*configservice.OrganizationConformancePackDetailedStatus
+
+	for {
+		output, err := conn.GetOrganizationConformancePackDetailedStatus(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		statuses = append(statuses, output.OrganizationConformancePackDetailedStatuses...)
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return statuses, nil
+}
+
+func configRefreshConformancePackStatus(conn *configservice.ConfigService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		status, err := configDescribeConformancePackStatus(conn, name)
+
+		if isAWSErr(err, configservice.ErrCodeNoSuchConformancePackException, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if status == nil {
+			return nil, "", nil
+		}
+
+		switch aws.StringValue(status.ConformancePackState) {
+		case configservice.ConformancePackStateCreateFailed, configservice.ConformancePackStateDeleteFailed:
+			return status, aws.StringValue(status.ConformancePackState), fmt.Errorf("%s", aws.StringValue(status.ConformancePackStatusReason))
+		}
+
+		return status, aws.StringValue(status.ConformancePackState), nil
+	}
+}
+
+func configRefreshOrganizationConformancePackStatus(conn *configservice.ConfigService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		status, err := configDescribeOrganizationConformancePackStatus(conn, name)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if status == nil {
+			return nil, "", fmt.Errorf("status not found")
+		}
+
+		if status.ErrorCode != nil {
+			return status, aws.StringValue(status.Status), fmt.Errorf("%s: %s", aws.StringValue(status.ErrorCode), aws.StringValue(status.ErrorMessage))
+		}
+
+		switch aws.StringValue(status.Status) {
+		case configservice.OrganizationResourceStatusCreateFailed, configservice.OrganizationResourceStatusDeleteFailed, configservice.OrganizationResourceStatusUpdateFailed:
+			// Display detailed errors for failed member accounts
+			memberAccountStatuses, err := configGetOrganizationConformancePackDetailedStatus(conn, name, aws.StringValue(status.Status))
+
+			if err != nil {
+				return status, aws.StringValue(status.Status), fmt.Errorf("unable to get Organization Conformance Pack detailed status for showing member account errors: %s", err)
+			}
+
+			var errBuilder strings.Builder
+
+			for _, mas := range memberAccountStatuses {
+				errBuilder.WriteString(fmt.Sprintf("Account ID (%s): %s: %s\n", aws.StringValue(mas.AccountId), aws.StringValue(mas.ErrorCode), aws.StringValue(mas.ErrorMessage)))
+			}
+
+			return status, aws.StringValue(status.Status), fmt.Errorf("Failed in %d account(s):\n\n%s", len(memberAccountStatuses), errBuilder.String())
+		}
+
+		return status, aws.StringValue(status.Status), nil
+	}
+}
+
+func configWaitForConformancePackStateCreateComplete(conn *configservice.ConfigService, name string, timeout time.Duration) error {
+	stateChangeConf := &resource.StateChangeConf{
+		Pending: []string{configservice.ConformancePackStateCreateInProgress},
+		Target:  []string{configservice.ConformancePackStateCreateComplete},
+		Refresh: configRefreshConformancePackStatus(conn, name),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateChangeConf.WaitForState()
+
+	return err
+}
+
+func configWaitForConformancePackStateDeleteComplete(conn *configservice.ConfigService, name string, timeout time.Duration) error {
+	stateChangeConf := &resource.StateChangeConf{
+		Pending: []string{configservice.ConformancePackStateDeleteInProgress},
+		Target:  []string{},
+		Refresh: configRefreshConformancePackStatus(conn, name),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateChangeConf.WaitForState()
+
+	return err
+}
+
+func configWaitForOrganizationConformancePackStatusCreateSuccessful(conn *configservice.ConfigService, name string, timeout time.Duration) error {
+	stateChangeConf := &resource.StateChangeConf{
+		Pending: []string{configservice.OrganizationResourceStatusCreateInProgress},
+		Target:  []string{configservice.OrganizationResourceStatusCreateSuccessful},
+		Refresh: configRefreshOrganizationConformancePackStatus(conn, name),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateChangeConf.WaitForState()
+
+	return err
+}
+
+func configWaitForOrganizationConformancePackStatusDeleteSuccessful(conn *configservice.ConfigService, name string, timeout time.Duration) error {
+	stateChangeConf := &resource.StateChangeConf{
+		Pending: []string{configservice.OrganizationResourceStatusDeleteInProgress},
+		Target:  []string{configservice.OrganizationResourceStatusDeleteSuccessful},
+		Refresh: configRefreshOrganizationConformancePackStatus(conn, name),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateChangeConf.WaitForState()
+
+	if isAWSErr(err, configservice.ErrCodeNoSuchOrganizationConformancePackException, "") {
+		return nil
+	}
+
+	return err
+}
+
+func configWaitForOrganizationConformancePackStatusUpdateSuccessful(conn *configservice.ConfigService, name string, timeout time.Duration) error {
+	stateChangeConf := &resource.StateChangeConf{
+		Pending: []string{configservice.OrganizationResourceStatusUpdateInProgress},
+		Target:  []string{configservice.OrganizationResourceStatusUpdateSuccessful},
+		Refresh: configRefreshOrganizationConformancePackStatus(conn, name),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateChangeConf.WaitForState()
+
+	return err
+}
@@ -0,0 +1,82 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsDbInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsDbInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": rdsCustomFiltersSchema(),
+			"instance_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instance_identifiers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func dataSourceAwsDbInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	input := &rds.DescribeDBInstancesInput{}
+
+	if v, ok := d.GetOk("filter"); ok && v.(*schema.Set).Len() > 0 {
+		input.Filters = buildRDSCustomFilterList(v.(*schema.Set))
+	}
+
+	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws()
+
+	var arns, identifiers []string
+
+	err := conn.DescribeDBInstancesPages(input, func(page *rds.DescribeDBInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, dbInstance := range page.DBInstances {
+			if dbInstance == nil {
+				continue
+			}
+
+			if len(tags) > 0 && !keyvaluetags.RdsKeyValueTags(dbInstance.TagList).ContainsAll(tags) {
+				continue
+			}
+
+			arns = append(arns, aws.StringValue(dbInstance.DBInstanceArn))
+			identifiers = append(identifiers, aws.StringValue(dbInstance.DBInstanceIdentifier))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading DB Instances: %w", err)
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	if err := d.Set("instance_arns", arns); err != nil {
+		return fmt.Errorf("error setting instance_arns: %w", err)
+	}
+
+	if err := d.Set("instance_identifiers", identifiers); err != nil {
+		return fmt.Errorf("error setting instance_identifiers: %w", err)
+	}
+
+	return nil
+}
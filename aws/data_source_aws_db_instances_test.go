@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsDbInstances_filter(t *testing.T) {
+	dataSourceName := "data.aws_db_instances.test"
+	resourceName := "aws_db_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsDbInstancesConfigFilter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "instance_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_arns.0", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_identifiers.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_identifiers.0", resourceName, "identifier"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsDbInstances_tags(t *testing.T) {
+	dataSourceName := "data.aws_db_instances.test"
+	resourceName := "aws_db_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsDbInstancesConfigTags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "instance_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_arns.0", resourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsDbInstancesConfigBase(rName string) string {
+	return composeConfig(
+		testAccAWSDBInstanceConfig_orderableClassMysql(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier          = %[1]q
+  allocated_storage   = 10
+  engine              = data.aws_rds_orderable_db_instance.test.engine
+  instance_class      = data.aws_rds_orderable_db_instance.test.instance_class
+  name                = "test"
+  password            = "avoid-plaintext-passwords"
+  username            = "tfacctest"
+  skip_final_snapshot = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccDataSourceAwsDbInstancesConfigFilter(rName string) string {
+	return composeConfig(
+		testAccDataSourceAwsDbInstancesConfigBase(rName),
+		`
+data "aws_db_instances" "test" {
+  filter {
+    name   = "db-instance-id"
+    values = [aws_db_instance.test.identifier]
+  }
+}
+`)
+}
+
+func testAccDataSourceAwsDbInstancesConfigTags(rName string) string {
+	return composeConfig(
+		testAccDataSourceAwsDbInstancesConfigBase(rName),
+		`
+data "aws_db_instances" "test" {
+  tags = {
+    Name = aws_db_instance.test.tags["Name"]
+  }
+}
+`)
+}
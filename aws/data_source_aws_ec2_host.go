@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+)
+
+func dataSourceAwsEc2Host() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEc2HostRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"auto_placement": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cores": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"filter": ec2CustomFiltersSchema(),
+
+			"host_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"host_recovery": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"instance_family": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"instance_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"sockets": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"tags": tagsSchemaComputed(),
+
+			"total_vcpus": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsEc2HostRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	filters := buildEC2CustomFilterList(d.Get("filter").(*schema.Set))
+
+	if tags, tagsOk := d.GetOk("tags"); tagsOk {
+		filters = append(filters, buildEC2TagFilterList(
+			keyvaluetags.New(tags.(map[string]interface{})).Ec2Tags(),
+		)...)
+	}
+
+	host, err := finder.HostByIDAndFilters(conn, d.Get("host_id").(string), filters)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Host: %w", err)
+	}
+
+	if host == nil {
+		return fmt.Errorf("no matching EC2 Host found")
+	}
+
+	d.SetId(aws.StringValue(host.HostId))
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*AWSClient).region,
+		AccountID: aws.StringValue(host.OwnerId),
+		Resource:  fmt.Sprintf("dedicated-host/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("auto_placement", host.AutoPlacement)
+	d.Set("availability_zone", host.AvailabilityZone)
+	d.Set("host_id", host.HostId)
+	d.Set("host_recovery", host.HostRecovery)
+	if v := host.HostProperties; v != nil {
+		d.Set("cores", v.Cores)
+		d.Set("instance_family", v.InstanceFamily)
+		d.Set("instance_type", v.InstanceType)
+		d.Set("sockets", v.Sockets)
+		d.Set("total_vcpus", v.TotalVCpus)
+	}
+	d.Set("owner_id", host.OwnerId)
+
+	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(host.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSEc2HostDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ec2_host.test"
+	resourceName := "aws_ec2_host.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2HostDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "auto_placement", resourceName, "auto_placement"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "availability_zone", resourceName, "availability_zone"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "cores"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "host_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "host_recovery", resourceName, "host_recovery"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_family", resourceName, "instance_family"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_type", resourceName, "instance_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "owner_id", resourceName, "owner_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sockets"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "total_vcpus"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2HostDataSource_Filter(t *testing.T) {
+	dataSourceName := "data.aws_ec2_host.test"
+	resourceName := "aws_ec2_host.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2HostDataSourceConfigFilter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "host_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_type", resourceName, "instance_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEc2HostDataSourceConfigBase(rName string) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_ec2_host" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  instance_type     = "a1.large"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccEc2HostDataSourceConfig(rName string) string {
+	return composeConfig(testAccEc2HostDataSourceConfigBase(rName), `
+data "aws_ec2_host" "test" {
+  host_id = aws_ec2_host.test.id
+}
+`)
+}
+
+func testAccEc2HostDataSourceConfigFilter(rName string) string {
+	return composeConfig(testAccEc2HostDataSourceConfigBase(rName), `
+data "aws_ec2_host" "test" {
+  filter {
+    name   = "availability-zone"
+    values = [aws_ec2_host.test.availability_zone]
+  }
+
+  filter {
+    name   = "tag:Name"
+    values = [aws_ec2_host.test.tags["Name"]]
+  }
+}
+`)
+}
@@ -63,6 +63,11 @@ func dataSourceAwsEc2TransitGateway() *schema.Resource {
 				Computed: true,
 			},
 			"tags": tagsSchemaComputed(),
+			"transit_gateway_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"vpn_ecmp_support": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -125,6 +130,10 @@ func dataSourceAwsEc2TransitGatewayRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("transit_gateway_cidr_blocks", aws.StringValueSlice(transitGateway.Options.TransitGatewayCidrBlocks)); err != nil {
+		return fmt.Errorf("error setting transit_gateway_cidr_blocks: %s", err)
+	}
+
 	d.Set("vpn_ecmp_support", transitGateway.Options.VpnEcmpSupport)
 
 	d.SetId(aws.StringValue(transitGateway.TransitGatewayId))
@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+// ecsDescribeClustersMaxItems is the maximum number of clusters accepted by a single DescribeClusters call.
+const ecsDescribeClustersMaxItems = 100
+
+func dataSourceAwsEcsClusters() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEcsClustersRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"cluster_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func dataSourceAwsEcsClustersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecsconn
+
+	var clusterARNs []*string
+
+	err := conn.ListClustersPages(&ecs.ListClustersInput{}, func(page *ecs.ListClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		clusterARNs = append(clusterARNs, page.ClusterArns...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing ECS Clusters: %w", err)
+	}
+
+	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws()
+
+	var arns, names []string
+
+	for i := 0; i < len(clusterARNs); i += ecsDescribeClustersMaxItems {
+		j := i + ecsDescribeClustersMaxItems
+		if j > len(clusterARNs) {
+			j = len(clusterARNs)
+		}
+
+		input := &ecs.DescribeClustersInput{
+			Clusters: clusterARNs[i:j],
+		}
+
+		if len(tags) > 0 {
+			input.Include = aws.StringSlice([]string{ecs.ClusterFieldTags})
+		}
+
+		output, err := conn.DescribeClusters(input)
+
+		if err != nil {
+			return fmt.Errorf("error describing ECS Clusters: %w", err)
+		}
+
+		for _, cluster := range output.Clusters {
+			if cluster == nil {
+				continue
+			}
+
+			if v, ok := d.GetOk("status"); ok && v.(string) != aws.StringValue(cluster.Status) {
+				continue
+			}
+
+			if len(tags) > 0 && !keyvaluetags.EcsKeyValueTags(cluster.Tags).ContainsAll(tags) {
+				continue
+			}
+
+			arns = append(arns, aws.StringValue(cluster.ClusterArn))
+			names = append(names, aws.StringValue(cluster.ClusterName))
+		}
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	if err := d.Set("cluster_arns", arns); err != nil {
+		return fmt.Errorf("error setting cluster_arns: %w", err)
+	}
+
+	if err := d.Set("cluster_names", names); err != nil {
+		return fmt.Errorf("error setting cluster_names: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsEcsClusters_tags(t *testing.T) {
+	dataSourceName := "data.aws_ecs_clusters.test"
+	resourceName := "aws_ecs_cluster.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsEcsClustersConfigTags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cluster_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster_arns.0", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "cluster_names.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster_names.0", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsEcsClustersConfigTags(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ecs_clusters" "test" {
+  status = "ACTIVE"
+
+  tags = {
+    Name = aws_ecs_cluster.test.tags["Name"]
+  }
+}
+`, rName)
+}
@@ -0,0 +1,133 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+// ecsDescribeServicesMaxItems is the maximum number of services accepted by a single DescribeServices call.
+const ecsDescribeServicesMaxItems = 10
+
+func dataSourceAwsEcsServices() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEcsServicesRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"launch_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ecs.LaunchType_Values(), false),
+			},
+			"scheduling_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ecs.SchedulingStrategy_Values(), false),
+			},
+			"service_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"service_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func dataSourceAwsEcsServicesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecsconn
+
+	clusterARN := d.Get("cluster_arn").(string)
+
+	input := &ecs.ListServicesInput{
+		Cluster: aws.String(clusterARN),
+	}
+
+	if v, ok := d.GetOk("launch_type"); ok {
+		input.LaunchType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("scheduling_strategy"); ok {
+		input.SchedulingStrategy = aws.String(v.(string))
+	}
+
+	var serviceARNs []*string
+
+	err := conn.ListServicesPages(input, func(page *ecs.ListServicesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		serviceARNs = append(serviceARNs, page.ServiceArns...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing ECS Services in Cluster (%s): %w", clusterARN, err)
+	}
+
+	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws()
+
+	var arns, names []string
+
+	for i := 0; i < len(serviceARNs); i += ecsDescribeServicesMaxItems {
+		j := i + ecsDescribeServicesMaxItems
+		if j > len(serviceARNs) {
+			j = len(serviceARNs)
+		}
+
+		input := &ecs.DescribeServicesInput{
+			Cluster:  aws.String(clusterARN),
+			Services: serviceARNs[i:j],
+		}
+
+		if len(tags) > 0 {
+			input.Include = aws.StringSlice([]string{ecs.ServiceFieldTags})
+		}
+
+		output, err := conn.DescribeServices(input)
+
+		if err != nil {
+			return fmt.Errorf("error describing ECS Services in Cluster (%s): %w", clusterARN, err)
+		}
+
+		for _, service := range output.Services {
+			if service == nil {
+				continue
+			}
+
+			if len(tags) > 0 && !keyvaluetags.EcsKeyValueTags(service.Tags).ContainsAll(tags) {
+				continue
+			}
+
+			arns = append(arns, aws.StringValue(service.ServiceArn))
+			names = append(names, aws.StringValue(service.ServiceName))
+		}
+	}
+
+	d.SetId(clusterARN)
+
+	if err := d.Set("service_arns", arns); err != nil {
+		return fmt.Errorf("error setting service_arns: %w", err)
+	}
+
+	if err := d.Set("service_names", names); err != nil {
+		return fmt.Errorf("error setting service_names: %w", err)
+	}
+
+	return nil
+}
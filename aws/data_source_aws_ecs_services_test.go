@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsEcsServices_basic(t *testing.T) {
+	dataSourceName := "data.aws_ecs_services.test"
+	resourceName := "aws_ecs_service.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsEcsServicesConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "service_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "service_arns.0", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "service_names.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "service_names.0", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsEcsServices_tags(t *testing.T) {
+	dataSourceName := "data.aws_ecs_services.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsEcsServicesConfigTags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "service_arns.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "service_names.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsEcsServicesConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": 128,
+    "memoryReservation": 64,
+    "name": "mongodb"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  desired_count   = 0
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccDataSourceAwsEcsServicesConfigBasic(rName string) string {
+	return composeConfig(
+		testAccDataSourceAwsEcsServicesConfigBase(rName),
+		`
+data "aws_ecs_services" "test" {
+  cluster_arn = aws_ecs_service.test.cluster
+}
+`)
+}
+
+func testAccDataSourceAwsEcsServicesConfigTags(rName string) string {
+	return composeConfig(
+		testAccDataSourceAwsEcsServicesConfigBase(rName),
+		`
+data "aws_ecs_services" "test" {
+  cluster_arn = aws_ecs_service.test.cluster
+
+  tags = {
+    Name = "does-not-exist"
+  }
+}
+`)
+}
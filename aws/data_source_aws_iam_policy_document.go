@@ -31,6 +31,14 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"override_policy_documents": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
 			"policy_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -39,6 +47,14 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"source_policy_documents": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
 			"statement": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -113,6 +129,42 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 		}
 	}
 
+	// merge source_policy_documents in the order specified, requiring Sids to be unique
+	if v, ok := d.GetOk("source_policy_documents"); ok && len(v.([]interface{})) > 0 {
+		sidMap := make(map[string]struct{})
+
+		for _, stmt := range mergedDoc.Statements {
+			if len(stmt.Sid) > 0 {
+				sidMap[stmt.Sid] = struct{}{}
+			}
+		}
+
+		for i, sourceJSON := range v.([]interface{}) {
+			if sourceJSON == nil {
+				continue
+			}
+
+			sourceDoc := &IAMPolicyDoc{}
+			if err := json.Unmarshal([]byte(sourceJSON.(string)), sourceDoc); err != nil {
+				return fmt.Errorf("error reading source_policy_documents (item %d): %w", i, err)
+			}
+
+			for j, stmt := range sourceDoc.Statements {
+				if len(stmt.Sid) == 0 {
+					continue
+				}
+
+				if _, ok := sidMap[stmt.Sid]; ok {
+					return fmt.Errorf("Found duplicate sid (%s) in source_policy_documents (item %d, statement %d). Either remove the sid or ensure the sid is unique across all source documents.", stmt.Sid, i, j)
+				}
+
+				sidMap[stmt.Sid] = struct{}{}
+			}
+
+			mergedDoc.Merge(sourceDoc)
+		}
+	}
+
 	// process the current document
 	doc := &IAMPolicyDoc{
 		Version: d.Get("version").(string),
@@ -213,6 +265,22 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 		mergedDoc.Merge(overrideDoc)
 	}
 
+	// merge override_policy_documents in the order specified, later documents overriding earlier Sids
+	if v, ok := d.GetOk("override_policy_documents"); ok && len(v.([]interface{})) > 0 {
+		for i, overrideJSON := range v.([]interface{}) {
+			if overrideJSON == nil {
+				continue
+			}
+
+			overrideDoc := &IAMPolicyDoc{}
+			if err := json.Unmarshal([]byte(overrideJSON.(string)), overrideDoc); err != nil {
+				return fmt.Errorf("error reading override_policy_documents (item %d): %w", i, err)
+			}
+
+			mergedDoc.Merge(overrideDoc)
+		}
+	}
+
 	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
 	if err != nil {
 		// should never happen if the above code is correct
@@ -146,6 +146,44 @@ func TestAccAWSDataSourceIAMPolicyDocument_duplicateSid(t *testing.T) {
 	})
 }
 
+func TestAccAWSDataSourceIAMPolicyDocument_sourcePolicyDocuments(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyDocumentSourcePolicyDocumentsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", testAccAWSIAMPolicyDocumentSourcePolicyDocumentsExpectedJSON),
+				),
+			},
+			{
+				Config:      testAccAWSIAMPolicyDocumentSourcePolicyDocumentsDuplicateSidConfig,
+				ExpectError: regexp.MustCompile(`Found duplicate sid \(SourcePolicyDocumentsDuplicate\) in source_policy_documents`),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPolicyDocument_overridePolicyDocuments(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyDocumentOverridePolicyDocumentsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", testAccAWSIAMPolicyDocumentOverridePolicyDocumentsExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/10777
 func TestAccAWSDataSourceIAMPolicyDocument_Statement_Principal_Identifiers_StringAndSlice(t *testing.T) {
 	dataSourceName := "data.aws_iam_policy_document.test"
@@ -671,6 +709,163 @@ var testAccAWSIAMPolicyDocumentOverrideExpectedJSON = `{
   ]
 }`
 
+var testAccAWSIAMPolicyDocumentSourcePolicyDocumentsConfig = `
+data "aws_iam_policy_document" "source_one" {
+  statement {
+    actions   = ["ec2:*"]
+    resources = ["*"]
+  }
+
+  statement {
+    sid       = "SourcePolicyDocumentsOne"
+    actions   = ["s3:*"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "source_two" {
+  statement {
+    sid       = "SourcePolicyDocumentsTwo"
+    actions   = ["iam:*"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test" {
+  source_policy_documents = [
+    data.aws_iam_policy_document.source_one.json,
+    data.aws_iam_policy_document.source_two.json,
+  ]
+
+  statement {
+    sid       = "SourcePolicyDocumentsOne"
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+`
+
+var testAccAWSIAMPolicyDocumentSourcePolicyDocumentsExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "ec2:*",
+      "Resource": "*"
+    },
+    {
+      "Sid": "SourcePolicyDocumentsOne",
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "*"
+    },
+    {
+      "Sid": "SourcePolicyDocumentsTwo",
+      "Effect": "Allow",
+      "Action": "iam:*",
+      "Resource": "*"
+    }
+  ]
+}`
+
+var testAccAWSIAMPolicyDocumentSourcePolicyDocumentsDuplicateSidConfig = `
+data "aws_iam_policy_document" "source_one" {
+  statement {
+    sid       = "SourcePolicyDocumentsDuplicate"
+    actions   = ["s3:*"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "source_two" {
+  statement {
+    sid       = "SourcePolicyDocumentsDuplicate"
+    actions   = ["iam:*"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test" {
+  source_policy_documents = [
+    data.aws_iam_policy_document.source_one.json,
+    data.aws_iam_policy_document.source_two.json,
+  ]
+}
+`
+
+var testAccAWSIAMPolicyDocumentOverridePolicyDocumentsConfig = `
+data "aws_iam_policy_document" "override_one" {
+  statement {
+    sid       = "OverridePolicyDocumentsOne"
+    actions   = ["s3:*"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "override_two" {
+  statement {
+    effect    = "Deny"
+    actions   = ["ec2:*"]
+    resources = ["*"]
+  }
+
+  statement {
+    sid       = "OverridePolicyDocumentsTwo"
+    actions   = ["iam:*"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "override_three" {
+  statement {
+    sid       = "OverridePolicyDocumentsOne"
+    effect    = "Deny"
+    actions   = ["logs:*"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test" {
+  override_policy_documents = [
+    data.aws_iam_policy_document.override_one.json,
+    data.aws_iam_policy_document.override_two.json,
+    data.aws_iam_policy_document.override_three.json,
+  ]
+
+  statement {
+    sid       = "OverridePolicyDocumentsTwo"
+    effect    = "Deny"
+    actions   = ["*"]
+    resources = ["*"]
+  }
+}
+`
+
+var testAccAWSIAMPolicyDocumentOverridePolicyDocumentsExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "OverridePolicyDocumentsTwo",
+      "Effect": "Allow",
+      "Action": "iam:*",
+      "Resource": "*"
+    },
+    {
+      "Sid": "OverridePolicyDocumentsOne",
+      "Effect": "Deny",
+      "Action": "logs:*",
+      "Resource": "*"
+    },
+    {
+      "Sid": "",
+      "Effect": "Deny",
+      "Action": "ec2:*",
+      "Resource": "*"
+    }
+  ]
+}`
+
 var testAccAWSIAMPolicyDocumentNoStatementMergeConfig = `
 data "aws_iam_policy_document" "source" {
   statement {
@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesis/finder"
+)
+
+func dataSourceAwsKinesisStreamConsumer() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsKinesisStreamConsumerRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
+				ExactlyOneOf: []string{"arn", "name"},
+			},
+
+			"creation_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+				ExactlyOneOf: []string{"arn", "name"},
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"stream_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func dataSourceAwsKinesisStreamConsumerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisconn
+
+	streamARN := d.Get("stream_arn").(string)
+
+	var consumer *kinesis.ConsumerDescription
+	var consumerIdentifier string
+	var err error
+
+	if v, ok := d.GetOk("arn"); ok {
+		consumerIdentifier = v.(string)
+		consumer, err = finder.StreamConsumerByARN(conn, consumerIdentifier)
+	} else {
+		consumerIdentifier = d.Get("name").(string)
+		consumer, err = finder.StreamConsumerByStreamARNAndName(conn, streamARN, consumerIdentifier)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Stream Consumer (%s): %w", consumerIdentifier, err)
+	}
+
+	if consumer == nil {
+		return fmt.Errorf("error reading Kinesis Stream Consumer (%s): not found", consumerIdentifier)
+	}
+
+	if aws.StringValue(consumer.StreamARN) != streamARN {
+		return fmt.Errorf("Kinesis Stream Consumer (%s) is not registered with Kinesis Stream (%s)", consumerIdentifier, streamARN)
+	}
+
+	d.SetId(aws.StringValue(consumer.ConsumerARN))
+	d.Set("arn", consumer.ConsumerARN)
+	d.Set("creation_timestamp", aws.TimeValue(consumer.ConsumerCreationTimestamp).Format(time.RFC3339))
+	d.Set("name", consumer.ConsumerName)
+	d.Set("status", consumer.ConsumerStatus)
+	d.Set("stream_arn", consumer.StreamARN)
+
+	return nil
+}
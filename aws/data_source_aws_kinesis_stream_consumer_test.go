@@ -0,0 +1,79 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSKinesisStreamConsumerDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_kinesis_stream_consumer.test"
+	resourceName := "aws_kinesis_stream_consumer.test"
+	streamName := "aws_kinesis_stream.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisStreamConsumerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisStreamConsumerDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "creation_timestamp", resourceName, "creation_timestamp"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stream_arn", streamName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisStreamConsumerDataSource_Arn(t *testing.T) {
+	dataSourceName := "data.aws_kinesis_stream_consumer.test"
+	resourceName := "aws_kinesis_stream_consumer.test"
+	streamName := "aws_kinesis_stream.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisStreamConsumerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisStreamConsumerDataSourceConfigArn(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stream_arn", streamName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSKinesisStreamConsumerDataSourceConfig(rName string) string {
+	return composeConfig(
+		testAccAWSKinesisStreamConsumerConfig_basic(rName),
+		`
+data "aws_kinesis_stream_consumer" "test" {
+  name       = aws_kinesis_stream_consumer.test.name
+  stream_arn = aws_kinesis_stream_consumer.test.stream_arn
+}
+`)
+}
+
+func testAccAWSKinesisStreamConsumerDataSourceConfigArn(rName string) string {
+	return composeConfig(
+		testAccAWSKinesisStreamConsumerConfig_basic(rName),
+		`
+data "aws_kinesis_stream_consumer" "test" {
+  arn        = aws_kinesis_stream_consumer.test.arn
+  stream_arn = aws_kinesis_stream_consumer.test.stream_arn
+}
+`)
+}
@@ -0,0 +1,102 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsLambdaFunctions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLambdaFunctionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"function_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"function_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"runtime": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func dataSourceAwsLambdaFunctionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lambdaconn
+
+	input := &lambda.ListFunctionsInput{}
+
+	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws()
+
+	var functions []*lambda.FunctionConfiguration
+
+	err := conn.ListFunctionsPages(input, func(page *lambda.ListFunctionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, function := range page.Functions {
+			if function == nil {
+				continue
+			}
+
+			if v, ok := d.GetOk("runtime"); ok && v.(string) != aws.StringValue(function.Runtime) {
+				continue
+			}
+
+			functions = append(functions, function)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Lambda Functions: %w", err)
+	}
+
+	var arns, names []string
+
+	for _, function := range functions {
+		arn := aws.StringValue(function.FunctionArn)
+
+		// ListFunctions does not return tags, so they are only read when filtering on them.
+		if len(tags) > 0 {
+			functionTags, err := keyvaluetags.LambdaListTags(conn, arn)
+
+			if err != nil {
+				return fmt.Errorf("error listing tags for Lambda Function (%s): %w", arn, err)
+			}
+
+			if !functionTags.ContainsAll(tags) {
+				continue
+			}
+		}
+
+		arns = append(arns, arn)
+		names = append(names, aws.StringValue(function.FunctionName))
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	if err := d.Set("function_arns", arns); err != nil {
+		return fmt.Errorf("error setting function_arns: %w", err)
+	}
+
+	if err := d.Set("function_names", names); err != nil {
+		return fmt.Errorf("error setting function_names: %w", err)
+	}
+
+	return nil
+}
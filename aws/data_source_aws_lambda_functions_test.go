@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsLambdaFunctions_tags(t *testing.T) {
+	dataSourceName := "data.aws_lambda_functions.test"
+	resourceName := "aws_lambda_function.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsLambdaFunctionsConfigTags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "function_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "function_arns.0", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "function_names.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "function_names.0", resourceName, "function_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsLambdaFunctionsConfigTags(rName string) string {
+	return composeConfig(
+		baseAccAWSLambdaConfig(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs12.x"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_lambda_functions" "test" {
+  runtime = aws_lambda_function.test.runtime
+
+  tags = {
+    Name = aws_lambda_function.test.tags["Name"]
+  }
+}
+`, rName))
+}
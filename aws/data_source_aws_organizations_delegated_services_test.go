@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testAccDataSourceAwsOrganizationsDelegatedServices_basic(t *testing.T) {
+	var providers []*schema.Provider
+	dataSourceName := "data.aws_organizations_delegated_services.test"
+	servicePrincipal := "config-multiaccountsetup.amazonaws.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+			testAccOrganizationsAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsOrganizationsDelegatedServicesConfig(servicePrincipal),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "delegated_services.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "delegated_services.*", map[string]string{
+						"service_principal": servicePrincipal,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsOrganizationsDelegatedServicesConfig(servicePrincipal string) string {
+	return composeConfig(testAccAlternateAccountProviderConfig(), fmt.Sprintf(`
+data "aws_caller_identity" "delegated" {
+  provider = "awsalternate"
+}
+
+resource "aws_organizations_delegated_administrator" "test" {
+  account_id        = data.aws_caller_identity.delegated.account_id
+  service_principal = %[1]q
+}
+
+data "aws_organizations_delegated_services" "test" {
+  account_id = aws_organizations_delegated_administrator.test.account_id
+}
+`, servicePrincipal))
+}
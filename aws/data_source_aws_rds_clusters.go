@@ -0,0 +1,82 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsRdsClusters() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsRdsClustersRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"cluster_identifiers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"filter": rdsCustomFiltersSchema(),
+			"tags":   tagsSchema(),
+		},
+	}
+}
+
+func dataSourceAwsRdsClustersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	input := &rds.DescribeDBClustersInput{}
+
+	if v, ok := d.GetOk("filter"); ok && v.(*schema.Set).Len() > 0 {
+		input.Filters = buildRDSCustomFilterList(v.(*schema.Set))
+	}
+
+	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws()
+
+	var arns, identifiers []string
+
+	err := conn.DescribeDBClustersPages(input, func(page *rds.DescribeDBClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, dbCluster := range page.DBClusters {
+			if dbCluster == nil {
+				continue
+			}
+
+			if len(tags) > 0 && !keyvaluetags.RdsKeyValueTags(dbCluster.TagList).ContainsAll(tags) {
+				continue
+			}
+
+			arns = append(arns, aws.StringValue(dbCluster.DBClusterArn))
+			identifiers = append(identifiers, aws.StringValue(dbCluster.DBClusterIdentifier))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS Clusters: %w", err)
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	if err := d.Set("cluster_arns", arns); err != nil {
+		return fmt.Errorf("error setting cluster_arns: %w", err)
+	}
+
+	if err := d.Set("cluster_identifiers", identifiers); err != nil {
+		return fmt.Errorf("error setting cluster_identifiers: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsRdsClusters_filter(t *testing.T) {
+	dataSourceName := "data.aws_rds_clusters.test"
+	resourceName := "aws_rds_cluster.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsRdsClustersConfigFilter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cluster_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster_arns.0", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "cluster_identifiers.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster_identifiers.0", resourceName, "cluster_identifier"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsRdsClusters_tags(t *testing.T) {
+	dataSourceName := "data.aws_rds_clusters.test"
+	resourceName := "aws_rds_cluster.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsRdsClustersConfigTags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cluster_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster_arns.0", resourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsRdsClustersConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster" "test" {
+  cluster_identifier  = %[1]q
+  database_name       = "test"
+  master_password     = "avoid-plaintext-passwords"
+  master_username     = "tfacctest"
+  skip_final_snapshot = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccDataSourceAwsRdsClustersConfigFilter(rName string) string {
+	return composeConfig(
+		testAccDataSourceAwsRdsClustersConfigBase(rName),
+		`
+data "aws_rds_clusters" "test" {
+  filter {
+    name   = "db-cluster-id"
+    values = [aws_rds_cluster.test.cluster_identifier]
+  }
+}
+`)
+}
+
+func testAccDataSourceAwsRdsClustersConfigTags(rName string) string {
+	return composeConfig(
+		testAccDataSourceAwsRdsClustersConfigBase(rName),
+		`
+data "aws_rds_clusters" "test" {
+  tags = {
+    Name = aws_rds_cluster.test.tags["Name"]
+  }
+}
+`)
+}
@@ -28,6 +28,86 @@ func describeFsxFileSystem(conn *fsx.FSx, id string) (*fsx.FileSystem, error) {
 	return filesystem, err
 }
 
+func describeFsxBackup(conn *fsx.FSx, id string) (*fsx.Backup, error) {
+	input := &fsx.DescribeBackupsInput{
+		BackupIds: []*string{aws.String(id)},
+	}
+	var backup *fsx.Backup
+
+	err := conn.DescribeBackupsPages(input, func(page *fsx.DescribeBackupsOutput, lastPage bool) bool {
+		for _, b := range page.Backups {
+			if aws.StringValue(b.BackupId) == id {
+				backup = b
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return backup, err
+}
+
+func describeFsxDataRepositoryTask(conn *fsx.FSx, id string) (*fsx.DataRepositoryTask, error) {
+	input := &fsx.DescribeDataRepositoryTasksInput{
+		TaskIds: []*string{aws.String(id)},
+	}
+	var task *fsx.DataRepositoryTask
+
+	err := conn.DescribeDataRepositoryTasksPages(input, func(page *fsx.DescribeDataRepositoryTasksOutput, lastPage bool) bool {
+		for _, t := range page.DataRepositoryTasks {
+			if aws.StringValue(t.TaskId) == id {
+				task = t
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return task, err
+}
+
+func refreshFsxBackupLifecycle(conn *fsx.FSx, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		backup, err := describeFsxBackup(conn, id)
+
+		if isAWSErr(err, fsx.ErrCodeBackupNotFound, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if backup == nil {
+			return nil, "", nil
+		}
+
+		return backup, aws.StringValue(backup.Lifecycle), nil
+	}
+}
+
+func refreshFsxDataRepositoryTaskLifecycle(conn *fsx.FSx, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		task, err := describeFsxDataRepositoryTask(conn, id)
+
+		if isAWSErr(err, fsx.ErrCodeDataRepositoryTaskNotFound, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if task == nil {
+			return nil, "", nil
+		}
+
+		return task, aws.StringValue(task.Lifecycle), nil
+	}
+}
+
 func refreshFsxFileSystemLifecycle(conn *fsx.FSx, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		filesystem, err := describeFsxFileSystem(conn, id)
@@ -139,3 +219,74 @@ func waitForFsxFileSystemUpdateAdministrativeActionsStatusFileSystemUpdate(conn
 
 	return err
 }
+
+func waitForFsxBackupAvailable(conn *fsx.FSx, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			fsx.BackupLifecycleCreating,
+			fsx.BackupLifecyclePending,
+			fsx.BackupLifecycleTransferring,
+		},
+		Target:  []string{fsx.BackupLifecycleAvailable},
+		Refresh: refreshFsxBackupLifecycle(conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForFsxBackupDeletion(conn *fsx.FSx, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{fsx.BackupLifecycleAvailable, fsx.BackupLifecycleDeleted},
+		Target:  []string{},
+		Refresh: refreshFsxBackupLifecycle(conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForFsxDataRepositoryTaskCompletion(conn *fsx.FSx, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			fsx.DataRepositoryTaskLifecycleExecuting,
+			fsx.DataRepositoryTaskLifecyclePending,
+		},
+		Target:  []string{fsx.DataRepositoryTaskLifecycleSucceeded},
+		Refresh: refreshFsxDataRepositoryTaskLifecycle(conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForFsxDataRepositoryTaskCancellation(conn *fsx.FSx, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			fsx.DataRepositoryTaskLifecycleCanceling,
+			fsx.DataRepositoryTaskLifecycleExecuting,
+			fsx.DataRepositoryTaskLifecyclePending,
+		},
+		Target: []string{
+			fsx.DataRepositoryTaskLifecycleCanceled,
+			fsx.DataRepositoryTaskLifecycleFailed,
+			fsx.DataRepositoryTaskLifecycleSucceeded,
+		},
+		Refresh: refreshFsxDataRepositoryTaskLifecycle(conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
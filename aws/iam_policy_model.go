@@ -2,8 +2,12 @@ package aws
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"regexp"
 	"sort"
+	"strconv"
+	"strings"
 )
 
 type IAMPolicyDoc struct {
@@ -143,7 +147,11 @@ func (ps *IAMPolicyStatementPrincipalSet) UnmarshalJSON(b []byte) error {
 			case []interface{}:
 				values := []string{}
 				for _, v := range value.([]interface{}) {
-					values = append(values, v.(string))
+					value, ok := v.(string)
+					if !ok {
+						return fmt.Errorf("Unsupported data type %T for IAMPolicyStatementPrincipalSet.Identifiers", v)
+					}
+					values = append(values, value)
 				}
 				out = append(out, IAMPolicyStatementPrincipal{Type: key, Identifiers: values})
 			default:
@@ -193,14 +201,22 @@ func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 	for test_key, test_value := range data {
 		for var_key, var_values := range test_value {
 			switch var_values := var_values.(type) {
-			case string:
-				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: []string{var_values}})
 			case []interface{}:
 				values := []string{}
 				for _, v := range var_values {
-					values = append(values, v.(string))
+					value, err := iamPolicyDecodeConditionValue(v)
+					if err != nil {
+						return err
+					}
+					values = append(values, value)
 				}
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: values})
+			default:
+				value, err := iamPolicyDecodeConditionValue(var_values)
+				if err != nil {
+					return err
+				}
+				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: []string{value}})
 			}
 		}
 	}
@@ -209,6 +225,21 @@ func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// iamPolicyDecodeConditionValue returns the string form of a condition value.
+// Boolean and numeric condition values are equivalent to their string forms.
+func iamPolicyDecodeConditionValue(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("Unsupported data type %T for IAMPolicyStatementCondition.Values", v)
+	}
+}
+
 func iamPolicyDecodeConfigStringList(lI []interface{}) interface{} {
 	if len(lI) == 1 {
 		return lI[0].(string)
@@ -220,3 +251,290 @@ func iamPolicyDecodeConfigStringList(lI []interface{}) interface{} {
 	sort.Sort(sort.Reverse(sort.StringSlice(ret)))
 	return ret
 }
+
+const (
+	// iamPolicyTypeIdentity is a policy attached to an IAM identity, which
+	// must not specify a principal.
+	iamPolicyTypeIdentity = "identity"
+	// iamPolicyTypeResource is a resource-based or role trust policy, which
+	// must specify a principal.
+	iamPolicyTypeResource = "resource"
+)
+
+var iamPolicyConditionOperators = []string{
+	"ArnEquals",
+	"ArnLike",
+	"ArnNotEquals",
+	"ArnNotLike",
+	"BinaryEquals",
+	"Bool",
+	"DateEquals",
+	"DateGreaterThan",
+	"DateGreaterThanEquals",
+	"DateLessThan",
+	"DateLessThanEquals",
+	"DateNotEquals",
+	"IpAddress",
+	"NotIpAddress",
+	"Null",
+	"NumericEquals",
+	"NumericGreaterThan",
+	"NumericGreaterThanEquals",
+	"NumericLessThan",
+	"NumericLessThanEquals",
+	"NumericNotEquals",
+	"StringEquals",
+	"StringEqualsIgnoreCase",
+	"StringLike",
+	"StringNotEquals",
+	"StringNotEqualsIgnoreCase",
+	"StringNotLike",
+}
+
+var iamPolicyDocumentElements = []string{"Id", "Statement", "Version"}
+
+var iamPolicyStatementElements = []string{
+	"Action",
+	"Condition",
+	"Effect",
+	"NotAction",
+	"NotPrincipal",
+	"NotResource",
+	"Principal",
+	"Resource",
+	"Sid",
+}
+
+var iamPolicyPrincipalTypes = []string{"*", "AWS", "CanonicalUser", "Federated", "Service"}
+
+var iamPolicyActionRegexp = regexp.MustCompile(`^(\*|[a-zA-Z0-9-]+:[a-zA-Z0-9*?-]+)$`)
+
+// iamPolicyDecodeDocument decodes a JSON policy document, rejecting unknown
+// elements. A single statement object is accepted in place of a list.
+func iamPolicyDecodeDocument(policy string) (*IAMPolicyDoc, error) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, err
+	}
+
+	if err := iamPolicyCheckElements(raw, iamPolicyDocumentElements); err != nil {
+		return nil, err
+	}
+
+	var rawStmts []interface{}
+
+	switch v := raw["Statement"].(type) {
+	case nil:
+		return nil, errors.New("missing required element \"Statement\"")
+	case map[string]interface{}:
+		rawStmts = []interface{}{v}
+	case []interface{}:
+		rawStmts = v
+	default:
+		return nil, fmt.Errorf("unsupported data type %T for \"Statement\"", v)
+	}
+
+	for i, rawStmtI := range rawStmts {
+		rawStmt, ok := rawStmtI.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Statement[%d]: unsupported data type %T", i, rawStmtI)
+		}
+
+		if err := iamPolicyCheckElements(rawStmt, iamPolicyStatementElements); err != nil {
+			return nil, fmt.Errorf("Statement[%d]: %w", i, err)
+		}
+
+		for _, k := range []string{"Principal", "NotPrincipal"} {
+			if v, ok := rawStmt[k].(string); ok && v != "*" {
+				return nil, fmt.Errorf("Statement[%d]: %s must be \"*\" or an object, got %q", i, k, v)
+			}
+		}
+	}
+
+	raw["Statement"] = rawStmts
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &IAMPolicyDoc{}
+	if err := json.Unmarshal(b, doc); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// iamPolicyCheckElements returns an error for the first element of m not in elements.
+func iamPolicyCheckElements(m map[string]interface{}, elements []string) error {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if !iamPolicyStringInSlice(k, elements, false) {
+			return fmt.Errorf("unsupported element %q", k)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks the policy document against the IAM policy grammar and
+// returns an error for each invalid element found.
+func (s *IAMPolicyDoc) Validate(policyType string) []error {
+	var errs []error
+
+	switch s.Version {
+	case "", "2008-10-17", "2012-10-17":
+	default:
+		errs = append(errs, fmt.Errorf("unsupported Version %q", s.Version))
+	}
+
+	for i, stmt := range s.Statements {
+		prefix := fmt.Sprintf("Statement[%d]", i)
+		if len(stmt.Sid) > 0 {
+			prefix = fmt.Sprintf("Statement[%d] (Sid %q)", i, stmt.Sid)
+		}
+
+		for _, err := range stmt.Validate(policyType) {
+			errs = append(errs, fmt.Errorf("%s: %w", prefix, err))
+		}
+	}
+
+	return errs
+}
+
+// Validate checks the policy statement against the IAM policy grammar and
+// returns an error for each invalid element found.
+func (s *IAMPolicyStatement) Validate(policyType string) []error {
+	var errs []error
+
+	switch s.Effect {
+	case "":
+		errs = append(errs, errors.New("missing required element \"Effect\""))
+	case "Allow", "Deny":
+	default:
+		errs = append(errs, fmt.Errorf("unsupported Effect %q, expected \"Allow\" or \"Deny\"", s.Effect))
+	}
+
+	switch {
+	case s.Actions == nil && s.NotActions == nil:
+		errs = append(errs, errors.New("one of \"Action\" or \"NotAction\" is required"))
+	case s.Actions != nil && s.NotActions != nil:
+		errs = append(errs, errors.New("only one of \"Action\" or \"NotAction\" can be specified"))
+	}
+
+	for _, element := range []iamPolicyElement{{"Action", s.Actions}, {"NotAction", s.NotActions}} {
+		values, err := iamPolicyStringValues(element.value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", element.name, err))
+			continue
+		}
+
+		for _, value := range values {
+			if !iamPolicyActionRegexp.MatchString(value) {
+				errs = append(errs, fmt.Errorf("%s %q must be \"*\" or of the form \"service:action\"", element.name, value))
+			}
+		}
+	}
+
+	switch {
+	case s.Resources != nil && s.NotResources != nil:
+		errs = append(errs, errors.New("only one of \"Resource\" or \"NotResource\" can be specified"))
+	case s.Resources == nil && s.NotResources == nil && policyType == iamPolicyTypeIdentity:
+		errs = append(errs, errors.New("one of \"Resource\" or \"NotResource\" is required"))
+	}
+
+	for _, element := range []iamPolicyElement{{"Resource", s.Resources}, {"NotResource", s.NotResources}} {
+		values, err := iamPolicyStringValues(element.value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", element.name, err))
+			continue
+		}
+
+		for _, value := range values {
+			// Partial ARNs are accepted when they end with a wildcard, e.g. "arn:aws:s3:*".
+			if value != "*" && (!strings.HasPrefix(value, "arn:") || (len(strings.SplitN(value, ":", 6)) < 6 && !strings.HasSuffix(value, "*"))) {
+				errs = append(errs, fmt.Errorf("%s %q must be \"*\" or an ARN", element.name, value))
+			}
+		}
+	}
+
+	switch {
+	case policyType == iamPolicyTypeIdentity && (len(s.Principals) > 0 || len(s.NotPrincipals) > 0):
+		errs = append(errs, errors.New("\"Principal\" and \"NotPrincipal\" are not supported in identity-based policies"))
+	case policyType == iamPolicyTypeResource && len(s.Principals) == 0 && len(s.NotPrincipals) == 0:
+		errs = append(errs, errors.New("one of \"Principal\" or \"NotPrincipal\" is required"))
+	case len(s.Principals) > 0 && len(s.NotPrincipals) > 0:
+		errs = append(errs, errors.New("only one of \"Principal\" or \"NotPrincipal\" can be specified"))
+	}
+
+	if len(s.NotPrincipals) > 0 && s.Effect == "Allow" {
+		errs = append(errs, errors.New("\"NotPrincipal\" is not supported with Effect \"Allow\""))
+	}
+
+	for _, principals := range []IAMPolicyStatementPrincipalSet{s.Principals, s.NotPrincipals} {
+		for _, principal := range principals {
+			if !iamPolicyStringInSlice(principal.Type, iamPolicyPrincipalTypes, false) {
+				errs = append(errs, fmt.Errorf("unsupported principal type %q", principal.Type))
+			}
+		}
+	}
+
+	for _, condition := range s.Conditions {
+		operator := condition.Test
+		operator = strings.TrimPrefix(operator, "ForAllValues:")
+		operator = strings.TrimPrefix(operator, "ForAnyValue:")
+		operator = strings.TrimSuffix(operator, "IfExists")
+
+		if !iamPolicyStringInSlice(operator, iamPolicyConditionOperators, true) {
+			errs = append(errs, fmt.Errorf("unsupported condition operator %q", condition.Test))
+		}
+	}
+
+	return errs
+}
+
+type iamPolicyElement struct {
+	name  string
+	value interface{}
+}
+
+// iamPolicyStringValues returns the values of a policy element that may be a
+// single string or a list of strings.
+func iamPolicyStringValues(v interface{}) ([]string, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case []string:
+		return v, nil
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, vI := range v {
+			value, ok := vI.(string)
+			if !ok {
+				return nil, fmt.Errorf("unsupported data type %T, expected string", vI)
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %T, expected string or list of strings", v)
+	}
+}
+
+func iamPolicyStringInSlice(v string, values []string, ignoreCase bool) bool {
+	for _, value := range values {
+		if v == value || (ignoreCase && strings.EqualFold(v, value)) {
+			return true
+		}
+	}
+
+	return false
+}
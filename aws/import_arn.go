@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// importIDFromARNFunc converts the ARN of a resource into its native import ID.
+type importIDFromARNFunc func(arn.ARN) (string, error)
+
+// importStateByARN wraps an importer State function so that resources can be
+// imported using either their native import ID or their full ARN. ARNs are
+// converted to the native import ID by idFromARN before next is called.
+//
+// The ARN must belong to the given service and, where the ARN contains them,
+// to the provider's partition, region and account.
+func importStateByARN(service string, idFromARN importIDFromARNFunc, next schema.StateFunc) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if arn.IsARN(d.Id()) {
+			client := meta.(*AWSClient)
+
+			id, err := importIDFromARN(d.Id(), service, idFromARN, client.partition, client.region, client.accountid)
+
+			if err != nil {
+				return nil, err
+			}
+
+			d.SetId(id)
+		}
+
+		if next == nil {
+			return []*schema.ResourceData{d}, nil
+		}
+
+		return next(d, meta)
+	}
+}
+
+// importIDFromARN parses an import ARN and converts it into the native import ID.
+func importIDFromARN(v, service string, idFromARN importIDFromARNFunc, partition, region, accountID string) (string, error) {
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing import ARN (%s): %w", v, err)
+	}
+
+	if parsedARN.Service != service {
+		return "", fmt.Errorf("import ARN (%s) service (%s) does not match expected service (%s)", v, parsedARN.Service, service)
+	}
+
+	if partition != "" && parsedARN.Partition != partition {
+		return "", fmt.Errorf("import ARN (%s) partition (%s) does not match provider partition (%s)", v, parsedARN.Partition, partition)
+	}
+
+	if region != "" && parsedARN.Region != "" && parsedARN.Region != region {
+		return "", fmt.Errorf("import ARN (%s) region (%s) does not match provider region (%s)", v, parsedARN.Region, region)
+	}
+
+	if accountID != "" && parsedARN.AccountID != "" && parsedARN.AccountID != accountID {
+		return "", fmt.Errorf("import ARN (%s) account (%s) does not match provider account (%s)", v, parsedARN.AccountID, accountID)
+	}
+
+	id, err := idFromARN(parsedARN)
+
+	if err != nil {
+		return "", fmt.Errorf("error converting import ARN (%s) to ID: %w", v, err)
+	}
+
+	return id, nil
+}
+
+// arnResourceIDFunc returns an importIDFromARNFunc for ARNs whose resource is
+// the resource type, the separator and the native ID, e.g. "vpc/vpc-12345678"
+// or "function:my-function". IDs containing the separator are rejected.
+func arnResourceIDFunc(resourceType, separator string) importIDFromARNFunc {
+	return func(v arn.ARN) (string, error) {
+		prefix := resourceType + separator
+
+		if !strings.HasPrefix(v.Resource, prefix) {
+			return "", fmt.Errorf("expected resource of the form %s<id>, got %q", prefix, v.Resource)
+		}
+
+		id := strings.TrimPrefix(v.Resource, prefix)
+
+		if id == "" || strings.Contains(id, separator) {
+			return "", fmt.Errorf("expected resource of the form %s<id>, got %q", prefix, v.Resource)
+		}
+
+		return id, nil
+	}
+}
+
+// arnResourcePathNameFunc returns an importIDFromARNFunc for IAM-style ARNs
+// whose resource is the resource type followed by an optional path and the
+// name, e.g. "role/service-role/my-role". The name is the native ID.
+func arnResourcePathNameFunc(resourceType string) importIDFromARNFunc {
+	return func(v arn.ARN) (string, error) {
+		prefix := resourceType + "/"
+
+		if !strings.HasPrefix(v.Resource, prefix) {
+			return "", fmt.Errorf("expected resource of the form %s[<path>/]<name>, got %q", prefix, v.Resource)
+		}
+
+		parts := strings.Split(strings.TrimPrefix(v.Resource, prefix), "/")
+		name := parts[len(parts)-1]
+
+		if name == "" {
+			return "", fmt.Errorf("expected resource of the form %s[<path>/]<name>, got %q", prefix, v.Resource)
+		}
+
+		return name, nil
+	}
+}
@@ -0,0 +1,184 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestImportIDFromARN(t *testing.T) {
+	testCases := []struct {
+		TestName    string
+		ARN         string
+		Service     string
+		IDFromARN   importIDFromARNFunc
+		ExpectedID  string
+		ExpectError bool
+	}{
+		{
+			TestName:   "EC2 resource",
+			ARN:        "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678",
+			Service:    "ec2",
+			IDFromARN:  arnResourceIDFunc("vpc", "/"),
+			ExpectedID: "vpc-12345678",
+		},
+		{
+			TestName:    "EC2 resource wrong resource type",
+			ARN:         "arn:aws:ec2:us-west-2:123456789012:subnet/subnet-12345678",
+			Service:     "ec2",
+			IDFromARN:   arnResourceIDFunc("vpc", "/"),
+			ExpectError: true,
+		},
+		{
+			TestName:    "wrong service",
+			ARN:         "arn:aws:iam::123456789012:role/test",
+			Service:     "ec2",
+			IDFromARN:   arnResourceIDFunc("role", "/"),
+			ExpectError: true,
+		},
+		{
+			TestName:    "wrong region",
+			ARN:         "arn:aws:ec2:us-east-1:123456789012:vpc/vpc-12345678",
+			Service:     "ec2",
+			IDFromARN:   arnResourceIDFunc("vpc", "/"),
+			ExpectError: true,
+		},
+		{
+			TestName:    "wrong account",
+			ARN:         "arn:aws:ec2:us-west-2:210987654321:vpc/vpc-12345678",
+			Service:     "ec2",
+			IDFromARN:   arnResourceIDFunc("vpc", "/"),
+			ExpectError: true,
+		},
+		{
+			TestName:    "wrong partition",
+			ARN:         "arn:aws-us-gov:ec2:us-west-2:123456789012:vpc/vpc-12345678",
+			Service:     "ec2",
+			IDFromARN:   arnResourceIDFunc("vpc", "/"),
+			ExpectError: true,
+		},
+		{
+			TestName:   "Lambda function",
+			ARN:        "arn:aws:lambda:us-west-2:123456789012:function:test",
+			Service:    "lambda",
+			IDFromARN:  arnResourceIDFunc("function", ":"),
+			ExpectedID: "test",
+		},
+		{
+			TestName:    "Lambda function qualified",
+			ARN:         "arn:aws:lambda:us-west-2:123456789012:function:test:1",
+			Service:     "lambda",
+			IDFromARN:   arnResourceIDFunc("function", ":"),
+			ExpectError: true,
+		},
+		{
+			TestName:   "IAM role without path",
+			ARN:        "arn:aws:iam::123456789012:role/test",
+			Service:    "iam",
+			IDFromARN:  arnResourcePathNameFunc("role"),
+			ExpectedID: "test",
+		},
+		{
+			TestName:   "IAM role with path",
+			ARN:        "arn:aws:iam::123456789012:role/service-role/path/test",
+			Service:    "iam",
+			IDFromARN:  arnResourcePathNameFunc("role"),
+			ExpectedID: "test",
+		},
+		{
+			TestName:    "IAM role missing name",
+			ARN:         "arn:aws:iam::123456789012:role/",
+			Service:     "iam",
+			IDFromARN:   arnResourcePathNameFunc("role"),
+			ExpectError: true,
+		},
+		{
+			TestName:   "S3 bucket",
+			ARN:        "arn:aws:s3:::test",
+			Service:    "s3",
+			IDFromARN:  resourceAwsS3BucketIDFromARN,
+			ExpectedID: "test",
+		},
+		{
+			TestName:    "S3 object",
+			ARN:         "arn:aws:s3:::test/key",
+			Service:     "s3",
+			IDFromARN:   resourceAwsS3BucketIDFromARN,
+			ExpectError: true,
+		},
+		{
+			TestName:   "CloudWatch Logs log group",
+			ARN:        "arn:aws:logs:us-west-2:123456789012:log-group:/test/group",
+			Service:    "logs",
+			IDFromARN:  resourceAwsCloudWatchLogGroupIDFromARN,
+			ExpectedID: "/test/group",
+		},
+		{
+			TestName:   "CloudWatch Logs log group wildcard",
+			ARN:        "arn:aws:logs:us-west-2:123456789012:log-group:/test/group:*",
+			Service:    "logs",
+			IDFromARN:  resourceAwsCloudWatchLogGroupIDFromARN,
+			ExpectedID: "/test/group",
+		},
+		{
+			TestName:    "CloudWatch Logs log stream",
+			ARN:         "arn:aws:logs:us-west-2:123456789012:log-group:/test/group:log-stream:test",
+			Service:     "logs",
+			IDFromARN:   resourceAwsCloudWatchLogGroupIDFromARN,
+			ExpectError: true,
+		},
+		{
+			TestName:   "CloudWatch Events rule default event bus",
+			ARN:        "arn:aws:events:us-west-2:123456789012:rule/test",
+			Service:    "events",
+			IDFromARN:  resourceAwsCloudWatchEventRuleIDFromARN,
+			ExpectedID: "test",
+		},
+		{
+			TestName:   "CloudWatch Events rule custom event bus",
+			ARN:        "arn:aws:events:us-west-2:123456789012:rule/bus/test",
+			Service:    "events",
+			IDFromARN:  resourceAwsCloudWatchEventRuleIDFromARN,
+			ExpectedID: "bus/test",
+		},
+		{
+			TestName:    "invalid ARN",
+			ARN:         "arn:aws:ec2",
+			Service:     "ec2",
+			IDFromARN:   arnResourceIDFunc("vpc", "/"),
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := importIDFromARN(testCase.ARN, testCase.Service, testCase.IDFromARN, "aws", "us-west-2", "123456789012")
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.ExpectedID {
+				t.Errorf("got %q, expected %q", got, testCase.ExpectedID)
+			}
+		})
+	}
+}
+
+// testAccARNImportStateIdFunc returns the ARN of a resource as its import ID.
+func testAccARNImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["arn"], nil
+	}
+}
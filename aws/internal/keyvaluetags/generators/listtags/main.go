@@ -106,6 +106,7 @@ var serviceNames = []string{
 	"sagemaker",
 	"securityhub",
 	"servicediscovery",
+	"sesv2",
 	"sfn",
 	"signer",
 	"sns",
@@ -89,6 +89,7 @@ var sliceServiceNames = []string{
 	"serverlessapplicationrepository",
 	"servicecatalog",
 	"servicediscovery",
+	"sesv2",
 	"sfn",
 	"sns",
 	"ssm",
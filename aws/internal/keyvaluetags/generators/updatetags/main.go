@@ -112,6 +112,7 @@ var serviceNames = []string{
 	"secretsmanager",
 	"securityhub",
 	"servicediscovery",
+	"sesv2",
 	"sfn",
 	"signer",
 	"sns",
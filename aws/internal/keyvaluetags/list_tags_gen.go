@@ -93,6 +93,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/sesv2"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/signer"
 	"github.com/aws/aws-sdk-go/service/sns"
@@ -1634,6 +1635,23 @@ func ServicediscoveryListTags(conn *servicediscovery.ServiceDiscovery, identifie
 	return ServicediscoveryKeyValueTags(output.Tags), nil
 }
 
+// Sesv2ListTags lists sesv2 service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func Sesv2ListTags(conn *sesv2.SESV2, identifier string) (KeyValueTags, error) {
+	input := &sesv2.ListTagsForResourceInput{
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return Sesv2KeyValueTags(output.Tags), nil
+}
+
 // SfnListTags lists sfn service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
@@ -77,6 +77,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
 	"github.com/aws/aws-sdk-go/service/servicecatalog"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/sesv2"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -2552,6 +2553,33 @@ func ServicediscoveryKeyValueTags(tags []*servicediscovery.Tag) KeyValueTags {
 	return New(m)
 }
 
+// Sesv2Tags returns sesv2 service tags.
+func (tags KeyValueTags) Sesv2Tags() []*sesv2.Tag {
+	result := make([]*sesv2.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &sesv2.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// Sesv2KeyValueTags creates KeyValueTags from sesv2 service tags.
+func Sesv2KeyValueTags(tags []*sesv2.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
 // SfnTags returns sfn service tags.
 func (tags KeyValueTags) SfnTags() []*sfn.Tag {
 	result := make([]*sfn.Tag, 0, len(tags))
@@ -0,0 +1,183 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// adaptiveDecreaseFactor is applied to the send rate on each throttling error.
+	adaptiveDecreaseFactor = 0.7
+	// adaptiveIncreaseFactor is applied to the send rate on each successful request.
+	adaptiveIncreaseFactor = 1.05
+	// adaptiveMinRate is the lowest send rate, in requests per second, an
+	// adaptive Limiter backs off to.
+	adaptiveMinRate = 0.5
+)
+
+// Limiter is a token bucket rate limiter for API requests.
+//
+// A Limiter created with NewAdaptive additionally lowers its send rate whenever
+// the API responds with a throttling error and gradually raises it again, up to
+// the configured rate, as requests succeed.
+type Limiter struct {
+	lock sync.Mutex
+
+	adaptive bool
+	burst    float64
+	maxRate  float64
+	rate     float64
+	tokens   float64
+	updated  time.Time
+
+	// Requests sent in the current one second window, used to seed the send
+	// rate of an unlimited adaptive Limiter on the first throttling error.
+	measuredRate float64
+	windowCount  int
+	windowStart  time.Time
+}
+
+// New returns a Limiter allowing rate requests per second on average with
+// bursts of up to burst requests. A rate of zero disables rate limiting.
+func New(rate float64, burst int) *Limiter {
+	if burst <= 0 {
+		burst = int(math.Max(1, math.Ceil(rate)))
+	}
+
+	now := time.Now()
+
+	return &Limiter{
+		burst:       float64(burst),
+		maxRate:     rate,
+		rate:        rate,
+		tokens:      float64(burst),
+		updated:     now,
+		windowStart: now,
+	}
+}
+
+// NewAdaptive returns a Limiter that adapts its send rate to throttling
+// errors. The rate is never raised above the given rate; a rate of zero
+// leaves the Limiter unlimited until the first throttling error.
+func NewAdaptive(rate float64, burst int) *Limiter {
+	l := New(rate, burst)
+	l.adaptive = true
+
+	return l
+}
+
+// Rate returns the current send rate in requests per second. Zero means unlimited.
+func (l *Limiter) Rate() float64 {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.rate
+}
+
+// Wait blocks until a request may be sent or the context is done.
+func (l *Limiter) Wait(ctx context.Context) error {
+	for {
+		delay := l.reserve(time.Now())
+
+		if delay == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Throttled records a throttling error, lowering the send rate of an adaptive Limiter.
+func (l *Limiter) Throttled() {
+	if !l.adaptive {
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	l.refill(now)
+
+	rate := l.rate
+	if rate == 0 {
+		rate = math.Max(l.measuredRate, float64(l.windowCount)/math.Max(now.Sub(l.windowStart).Seconds(), 1))
+	}
+
+	l.rate = math.Max(rate*adaptiveDecreaseFactor, adaptiveMinRate)
+	l.tokens = math.Min(l.tokens, math.Max(1, math.Ceil(l.rate)))
+}
+
+// Succeeded records a successful request, raising the send rate of an
+// adaptive Limiter previously lowered by throttling errors.
+func (l *Limiter) Succeeded() {
+	if !l.adaptive {
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.rate == 0 {
+		return
+	}
+
+	l.refill(time.Now())
+	l.rate *= adaptiveIncreaseFactor
+
+	if l.maxRate > 0 && l.rate > l.maxRate {
+		l.rate = l.maxRate
+	}
+}
+
+// reserve takes a token if one is available and otherwise returns how long
+// to wait before trying again.
+func (l *Limiter) reserve(now time.Time) time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.refill(now)
+
+	if l.rate == 0 {
+		l.windowCount++
+		return 0
+	}
+
+	if l.tokens >= 1 {
+		l.tokens--
+		l.windowCount++
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// refill adds the tokens accrued since the last update and rolls the
+// measurement window.
+func (l *Limiter) refill(now time.Time) {
+	if elapsed := now.Sub(l.windowStart); elapsed >= time.Second {
+		l.measuredRate = float64(l.windowCount) / elapsed.Seconds()
+		l.windowCount = 0
+		l.windowStart = now
+	}
+
+	if l.rate > 0 {
+		burst := math.Max(l.burst, 1)
+		if l.adaptive {
+			burst = math.Min(burst, math.Max(1, math.Ceil(l.rate)))
+		}
+
+		l.tokens = math.Min(l.tokens+now.Sub(l.updated).Seconds()*l.rate, burst)
+	}
+
+	l.updated = now
+}
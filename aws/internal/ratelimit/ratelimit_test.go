@@ -0,0 +1,116 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLimiterWait(t *testing.T) {
+	l := New(20, 2)
+	ctx := context.Background()
+
+	start := time.Now()
+
+	// The burst is available immediately, the remaining requests are spaced
+	// 50ms apart.
+	for i := 0; i < 6; i++ {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("expected requests to be rate limited, took %s", elapsed)
+	}
+}
+
+func TestLimiterWaitUnlimited(t *testing.T) {
+	l := New(0, 0)
+	ctx := context.Background()
+
+	start := time.Now()
+
+	for i := 0; i < 1000; i++ {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected requests not to be rate limited, took %s", elapsed)
+	}
+}
+
+func TestLimiterWaitContextDone(t *testing.T) {
+	l := New(0.1, 1)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.Wait(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := l.Wait(ctx); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
+
+func TestLimiterAdaptive(t *testing.T) {
+	l := NewAdaptive(10, 0)
+
+	l.Throttled()
+
+	if got, expected := l.Rate(), 7.0; got != expected {
+		t.Errorf("got rate %f after throttling, expected %f", got, expected)
+	}
+
+	for i := 0; i < 100; i++ {
+		l.Succeeded()
+	}
+
+	if got, expected := l.Rate(), 10.0; got != expected {
+		t.Errorf("got rate %f after recovering, expected %f", got, expected)
+	}
+
+	for i := 0; i < 100; i++ {
+		l.Throttled()
+	}
+
+	if got, expected := l.Rate(), adaptiveMinRate; got != expected {
+		t.Errorf("got rate %f after repeated throttling, expected %f", got, expected)
+	}
+}
+
+func TestLimiterAdaptiveUnlimited(t *testing.T) {
+	l := NewAdaptive(0, 0)
+	ctx := context.Background()
+
+	for i := 0; i < 10; i++ {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	l.Succeeded()
+
+	if got := l.Rate(); got != 0 {
+		t.Errorf("got rate %f before throttling, expected unlimited", got)
+	}
+
+	l.Throttled()
+
+	if got := l.Rate(); got <= 0 {
+		t.Errorf("got rate %f after throttling, expected limited", got)
+	}
+}
+
+func TestLimiterNotAdaptive(t *testing.T) {
+	l := New(10, 0)
+
+	l.Throttled()
+
+	if got, expected := l.Rate(), 10.0; got != expected {
+		t.Errorf("got rate %f after throttling, expected %f", got, expected)
+	}
+}
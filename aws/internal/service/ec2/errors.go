@@ -8,6 +8,10 @@ const (
 	ErrCodeInvalidCarrierGatewayIDNotFound = "InvalidCarrierGatewayID.NotFound"
 )
 
+const (
+	ErrCodeInvalidHostIDNotFound = "InvalidHostID.NotFound"
+)
+
 const (
 	ErrCodeInvalidPrefixListIDNotFound = "InvalidPrefixListID.NotFound"
 )
@@ -19,11 +23,22 @@ const (
 	ErrCodeClientVpnRouteNotFound             = "InvalidClientVpnRouteNotFound"
 )
 
+const (
+	ErrCodeInvalidPermissionDuplicate = "InvalidPermission.Duplicate"
+	ErrCodeInvalidPermissionNotFound  = "InvalidPermission.NotFound"
+)
+
 const (
 	InvalidSecurityGroupIDNotFound = "InvalidSecurityGroupID.NotFound"
 	InvalidGroupNotFound           = "InvalidGroup.NotFound"
 )
 
+const (
+	ErrCodeInvalidRouteTableIDNotFound                = "InvalidRouteTableID.NotFound"
+	ErrCodeInvalidTransitGatewayAttachmentIDNotFound  = "InvalidTransitGatewayAttachmentID.NotFound"
+	ErrCodeInvalidTransitGatewayConnectPeerIDNotFound = "InvalidTransitGatewayConnectPeerID.NotFound"
+)
+
 const (
 	ErrCodeInvalidVpcPeeringConnectionIDNotFound = "InvalidVpcPeeringConnectionID.NotFound"
 )
@@ -166,3 +166,117 @@ func ManagedPrefixListByID(conn *ec2.EC2, id string) (*ec2.ManagedPrefixList, er
 
 	return output.PrefixLists[0], nil
 }
+
+// HostByID returns the EC2 Host corresponding to the specified identifier.
+// Returns nil and potentially an error if no EC2 Host is found.
+func HostByID(conn *ec2.EC2, id string) (*ec2.Host, error) {
+	return HostByIDAndFilters(conn, id, nil)
+}
+
+// HostByIDAndFilters returns the EC2 Host matching the specified identifier and filters.
+// Returns nil and potentially an error if no EC2 Host is found.
+func HostByIDAndFilters(conn *ec2.EC2, id string, filters []*ec2.Filter) (*ec2.Host, error) {
+	input := &ec2.DescribeHostsInput{}
+
+	if id != "" {
+		input.HostIds = aws.StringSlice([]string{id})
+	}
+
+	if len(filters) > 0 {
+		input.Filter = filters
+	}
+
+	output, err := conn.DescribeHosts(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Hosts) == 0 || output.Hosts[0] == nil {
+		return nil, nil
+	}
+
+	return output.Hosts[0], nil
+}
+
+// TransitGatewayConnectByID returns the EC2 Transit Gateway Connect attachment corresponding to the specified identifier.
+// Returns nil and potentially an error if no attachment is found.
+func TransitGatewayConnectByID(conn *ec2.EC2, id string) (*ec2.TransitGatewayConnect, error) {
+	input := &ec2.DescribeTransitGatewayConnectsInput{
+		TransitGatewayAttachmentIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeTransitGatewayConnects(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TransitGatewayConnects) == 0 || output.TransitGatewayConnects[0] == nil {
+		return nil, nil
+	}
+
+	return output.TransitGatewayConnects[0], nil
+}
+
+// TransitGatewayConnectPeerByID returns the EC2 Transit Gateway Connect peer corresponding to the specified identifier.
+// Returns nil and potentially an error if no peer is found.
+func TransitGatewayConnectPeerByID(conn *ec2.EC2, id string) (*ec2.TransitGatewayConnectPeer, error) {
+	input := &ec2.DescribeTransitGatewayConnectPeersInput{
+		TransitGatewayConnectPeerIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeTransitGatewayConnectPeers(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TransitGatewayConnectPeers) == 0 || output.TransitGatewayConnectPeers[0] == nil {
+		return nil, nil
+	}
+
+	return output.TransitGatewayConnectPeers[0], nil
+}
+
+// TransitGatewayPrefixListReference returns the reference to the specified prefix list in the specified EC2 Transit Gateway route table.
+// Returns nil and potentially an error if no reference is found.
+func TransitGatewayPrefixListReference(conn *ec2.EC2, transitGatewayRouteTableID, prefixListID string) (*ec2.TransitGatewayPrefixListReference, error) {
+	input := &ec2.GetTransitGatewayPrefixListReferencesInput{
+		Filters: tfec2.BuildAttributeFilterList(map[string]string{
+			"prefix-list-id": prefixListID,
+		}),
+		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+	}
+
+	var result *ec2.TransitGatewayPrefixListReference
+
+	err := conn.GetTransitGatewayPrefixListReferencesPages(input, func(page *ec2.GetTransitGatewayPrefixListReferencesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, reference := range page.TransitGatewayPrefixListReferences {
+			if reference == nil {
+				continue
+			}
+
+			if aws.StringValue(reference.PrefixListId) == prefixListID {
+				result = reference
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+// TransitGatewayPrefixListReferenceByID returns the EC2 Transit Gateway prefix list reference corresponding to the specified identifier.
+// Returns nil and potentially an error if no reference is found.
+func TransitGatewayPrefixListReferenceByID(conn *ec2.EC2, id string) (*ec2.TransitGatewayPrefixListReference, error) {
+	transitGatewayRouteTableID, prefixListID, err := tfec2.TransitGatewayPrefixListReferenceParseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return TransitGatewayPrefixListReference(conn, transitGatewayRouteTableID, prefixListID)
+}
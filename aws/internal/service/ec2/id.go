@@ -74,3 +74,22 @@ func ClientVpnRouteParseID(id string) (string, string, string, error) {
 func VpnGatewayVpcAttachmentCreateID(vpnGatewayID, vpcID string) string {
 	return fmt.Sprintf("vpn-attachment-%x", hashcode.String(fmt.Sprintf("%s-%s", vpcID, vpnGatewayID)))
 }
+
+const transitGatewayPrefixListReferenceIDSeparator = "_"
+
+func TransitGatewayPrefixListReferenceCreateID(transitGatewayRouteTableID, prefixListID string) string {
+	parts := []string{transitGatewayRouteTableID, prefixListID}
+	id := strings.Join(parts, transitGatewayPrefixListReferenceIDSeparator)
+	return id
+}
+
+func TransitGatewayPrefixListReferenceParseID(id string) (string, string, error) {
+	parts := strings.Split(id, transitGatewayPrefixListReferenceIDSeparator)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected transit-gateway-route-table-id"+transitGatewayPrefixListReferenceIDSeparator+
+			"prefix-list-id", id)
+}
@@ -0,0 +1,103 @@
+package registry
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+)
+
+// SecurityGroupRules records, per security group and rule type ("ingress" or
+// "egress"), whether the rules are being changed inline by an
+// aws_security_group resource and which standalone rule resources manage
+// rules of the group. It is shared across all resources served by the
+// provider process so conflicting management can be detected at plan time.
+type SecurityGroupRules struct {
+	lock       sync.Mutex
+	inline     map[string]bool
+	standalone map[string]map[string]struct{}
+}
+
+// Returns a properly initialized SecurityGroupRules
+func NewSecurityGroupRules() *SecurityGroupRules {
+	return &SecurityGroupRules{
+		inline:     make(map[string]bool),
+		standalone: make(map[string]map[string]struct{}),
+	}
+}
+
+// SetInline records whether the inline rules of the given type are being
+// changed by the security group resource.
+func (r *SecurityGroupRules) SetInline(groupID, ruleType string, changing bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	key := registryKey(groupID, ruleType)
+
+	if !changing {
+		delete(r.inline, key)
+		return
+	}
+
+	log.Printf("[DEBUG] Security Group (%s) %s rules are changing inline", groupID, ruleType)
+	r.inline[key] = true
+}
+
+// Inline returns whether the inline rules of the given type are being changed
+// by the security group resource.
+func (r *SecurityGroupRules) Inline(groupID, ruleType string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.inline[registryKey(groupID, ruleType)]
+}
+
+// AddStandalone records that the standalone rule resource with the given ID
+// manages a rule of the given type in the security group.
+func (r *SecurityGroupRules) AddStandalone(groupID, ruleType, ruleID string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	key := registryKey(groupID, ruleType)
+
+	if _, ok := r.standalone[key]; !ok {
+		r.standalone[key] = make(map[string]struct{})
+	}
+
+	r.standalone[key][ruleID] = struct{}{}
+}
+
+// RemoveStandalone forgets the standalone rule resource with the given ID.
+func (r *SecurityGroupRules) RemoveStandalone(groupID, ruleType, ruleID string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	key := registryKey(groupID, ruleType)
+
+	delete(r.standalone[key], ruleID)
+
+	if len(r.standalone[key]) == 0 {
+		delete(r.standalone, key)
+	}
+}
+
+// Standalone returns the sorted IDs of the standalone rule resources managing
+// rules of the given type in the security group.
+func (r *SecurityGroupRules) Standalone(groupID, ruleType string) []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var ruleIDs []string
+
+	for ruleID := range r.standalone[registryKey(groupID, ruleType)] {
+		ruleIDs = append(ruleIDs, ruleID)
+	}
+
+	sort.Strings(ruleIDs)
+
+	return ruleIDs
+}
+
+func registryKey(groupID, ruleType string) string {
+	return fmt.Sprintf("%s/%s", groupID, ruleType)
+}
@@ -0,0 +1,55 @@
+package registry
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSecurityGroupRulesInline(t *testing.T) {
+	r := NewSecurityGroupRules()
+
+	r.SetInline("sg-1", "ingress", true)
+
+	if !r.Inline("sg-1", "ingress") {
+		t.Error("expected sg-1 ingress rules to be changing inline")
+	}
+
+	if r.Inline("sg-1", "egress") {
+		t.Error("expected sg-1 egress rules not to be changing inline")
+	}
+
+	if r.Inline("sg-2", "ingress") {
+		t.Error("expected sg-2 ingress rules not to be changing inline")
+	}
+
+	r.SetInline("sg-1", "ingress", false)
+
+	if r.Inline("sg-1", "ingress") {
+		t.Error("expected sg-1 ingress rules not to be changing inline after reset")
+	}
+}
+
+func TestSecurityGroupRulesStandalone(t *testing.T) {
+	r := NewSecurityGroupRules()
+
+	r.AddStandalone("sg-1", "ingress", "sgrule-2")
+	r.AddStandalone("sg-1", "ingress", "sgrule-1")
+	r.AddStandalone("sg-1", "ingress", "sgrule-1")
+	r.AddStandalone("sg-1", "egress", "sgrule-3")
+
+	if got, expected := r.Standalone("sg-1", "ingress"), []string{"sgrule-1", "sgrule-2"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+
+	if got, expected := r.Standalone("sg-1", "egress"), []string{"sgrule-3"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+
+	r.RemoveStandalone("sg-1", "ingress", "sgrule-1")
+	r.RemoveStandalone("sg-1", "ingress", "sgrule-2")
+	r.RemoveStandalone("sg-2", "ingress", "sgrule-4")
+
+	if got := r.Standalone("sg-1", "ingress"); len(got) != 0 {
+		t.Errorf("got %v, expected no standalone rules", got)
+	}
+}
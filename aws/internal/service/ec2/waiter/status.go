@@ -316,3 +316,117 @@ func ManagedPrefixListState(conn *ec2.EC2, prefixListId string) resource.StateRe
 		return managedPrefixList, aws.StringValue(managedPrefixList.State), nil
 	}
 }
+
+const (
+	hostStateNotFound = "NotFound"
+	hostStateUnknown  = "Unknown"
+)
+
+// HostState fetches the Host and its State
+func HostState(conn *ec2.EC2, hostID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		host, err := finder.HostByID(conn, hostID)
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidHostIDNotFound) {
+			return nil, hostStateNotFound, nil
+		}
+		if err != nil {
+			return nil, hostStateUnknown, err
+		}
+
+		if host == nil {
+			return nil, hostStateNotFound, nil
+		}
+
+		state := aws.StringValue(host.State)
+
+		if state == ec2.AllocationStateReleased || state == ec2.AllocationStateReleasedPermanentFailure {
+			return nil, hostStateNotFound, nil
+		}
+
+		return host, state, nil
+	}
+}
+
+const (
+	transitGatewayConnectStateNotFound = "NotFound"
+	transitGatewayConnectStateUnknown  = "Unknown"
+)
+
+// TransitGatewayConnectState fetches the TransitGatewayConnect and its State
+func TransitGatewayConnectState(conn *ec2.EC2, transitGatewayAttachmentID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		transitGatewayConnect, err := finder.TransitGatewayConnectByID(conn, transitGatewayAttachmentID)
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayAttachmentIDNotFound) {
+			return nil, transitGatewayConnectStateNotFound, nil
+		}
+		if err != nil {
+			return nil, transitGatewayConnectStateUnknown, err
+		}
+
+		if transitGatewayConnect == nil {
+			return nil, transitGatewayConnectStateNotFound, nil
+		}
+
+		state := aws.StringValue(transitGatewayConnect.State)
+
+		if state == ec2.TransitGatewayAttachmentStateDeleted {
+			return nil, transitGatewayConnectStateNotFound, nil
+		}
+
+		return transitGatewayConnect, state, nil
+	}
+}
+
+const (
+	transitGatewayConnectPeerStateNotFound = "NotFound"
+	transitGatewayConnectPeerStateUnknown  = "Unknown"
+)
+
+// TransitGatewayConnectPeerState fetches the TransitGatewayConnectPeer and its State
+func TransitGatewayConnectPeerState(conn *ec2.EC2, transitGatewayConnectPeerID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		transitGatewayConnectPeer, err := finder.TransitGatewayConnectPeerByID(conn, transitGatewayConnectPeerID)
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayConnectPeerIDNotFound) {
+			return nil, transitGatewayConnectPeerStateNotFound, nil
+		}
+		if err != nil {
+			return nil, transitGatewayConnectPeerStateUnknown, err
+		}
+
+		if transitGatewayConnectPeer == nil {
+			return nil, transitGatewayConnectPeerStateNotFound, nil
+		}
+
+		state := aws.StringValue(transitGatewayConnectPeer.State)
+
+		if state == ec2.TransitGatewayConnectPeerStateDeleted {
+			return nil, transitGatewayConnectPeerStateNotFound, nil
+		}
+
+		return transitGatewayConnectPeer, state, nil
+	}
+}
+
+const (
+	transitGatewayPrefixListReferenceStateNotFound = "NotFound"
+	transitGatewayPrefixListReferenceStateUnknown  = "Unknown"
+)
+
+// TransitGatewayPrefixListReferenceState fetches the TransitGatewayPrefixListReference and its State
+func TransitGatewayPrefixListReferenceState(conn *ec2.EC2, transitGatewayRouteTableID, prefixListID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		transitGatewayPrefixListReference, err := finder.TransitGatewayPrefixListReference(conn, transitGatewayRouteTableID, prefixListID)
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidRouteTableIDNotFound) {
+			return nil, transitGatewayPrefixListReferenceStateNotFound, nil
+		}
+		if err != nil {
+			return nil, transitGatewayPrefixListReferenceStateUnknown, err
+		}
+
+		if transitGatewayPrefixListReference == nil {
+			return nil, transitGatewayPrefixListReferenceStateNotFound, nil
+		}
+
+		return transitGatewayPrefixListReference, aws.StringValue(transitGatewayPrefixListReference.State), nil
+	}
+}
@@ -347,3 +347,199 @@ func ManagedPrefixListDeleted(conn *ec2.EC2, prefixListId string) error {
 
 	return nil
 }
+
+const (
+	HostCreatedTimeout = 10 * time.Minute
+	HostUpdatedTimeout = 10 * time.Minute
+	HostDeletedTimeout = 20 * time.Minute
+)
+
+func HostCreated(conn *ec2.EC2, hostID string) (*ec2.Host, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.AllocationStatePending},
+		Target:  []string{ec2.AllocationStateAvailable},
+		Refresh: HostState(conn, hostID),
+		Timeout: HostCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.Host); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func HostUpdated(conn *ec2.EC2, hostID string) (*ec2.Host, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.AllocationStatePending},
+		Target:  []string{ec2.AllocationStateAvailable},
+		Refresh: HostState(conn, hostID),
+		Timeout: HostUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.Host); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func HostDeleted(conn *ec2.EC2, hostID string) (*ec2.Host, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.AllocationStateAvailable},
+		Target:  []string{},
+		Refresh: HostState(conn, hostID),
+		Timeout: HostDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.Host); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+const (
+	TransitGatewayConnectCreatedTimeout = 10 * time.Minute
+	TransitGatewayConnectDeletedTimeout = 10 * time.Minute
+)
+
+func TransitGatewayConnectCreated(conn *ec2.EC2, transitGatewayAttachmentID string) (*ec2.TransitGatewayConnect, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayAttachmentStatePending},
+		Target:  []string{ec2.TransitGatewayAttachmentStateAvailable},
+		Refresh: TransitGatewayConnectState(conn, transitGatewayAttachmentID),
+		Timeout: TransitGatewayConnectCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayConnect); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func TransitGatewayConnectDeleted(conn *ec2.EC2, transitGatewayAttachmentID string) (*ec2.TransitGatewayConnect, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.TransitGatewayAttachmentStateAvailable,
+			ec2.TransitGatewayAttachmentStateDeleting,
+		},
+		Target:  []string{},
+		Refresh: TransitGatewayConnectState(conn, transitGatewayAttachmentID),
+		Timeout: TransitGatewayConnectDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayConnect); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+const (
+	TransitGatewayConnectPeerCreatedTimeout = 10 * time.Minute
+	TransitGatewayConnectPeerDeletedTimeout = 10 * time.Minute
+)
+
+func TransitGatewayConnectPeerCreated(conn *ec2.EC2, transitGatewayConnectPeerID string) (*ec2.TransitGatewayConnectPeer, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayConnectPeerStatePending},
+		Target:  []string{ec2.TransitGatewayConnectPeerStateAvailable},
+		Refresh: TransitGatewayConnectPeerState(conn, transitGatewayConnectPeerID),
+		Timeout: TransitGatewayConnectPeerCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayConnectPeer); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func TransitGatewayConnectPeerDeleted(conn *ec2.EC2, transitGatewayConnectPeerID string) (*ec2.TransitGatewayConnectPeer, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.TransitGatewayConnectPeerStateAvailable,
+			ec2.TransitGatewayConnectPeerStateDeleting,
+		},
+		Target:  []string{},
+		Refresh: TransitGatewayConnectPeerState(conn, transitGatewayConnectPeerID),
+		Timeout: TransitGatewayConnectPeerDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayConnectPeer); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+const (
+	TransitGatewayPrefixListReferenceTimeout = 5 * time.Minute
+)
+
+func TransitGatewayPrefixListReferenceStateCreated(conn *ec2.EC2, transitGatewayRouteTableID, prefixListID string) (*ec2.TransitGatewayPrefixListReference, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayPrefixListReferenceStatePending},
+		Target:  []string{ec2.TransitGatewayPrefixListReferenceStateAvailable},
+		Refresh: TransitGatewayPrefixListReferenceState(conn, transitGatewayRouteTableID, prefixListID),
+		Timeout: TransitGatewayPrefixListReferenceTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayPrefixListReference); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func TransitGatewayPrefixListReferenceStateDeleted(conn *ec2.EC2, transitGatewayRouteTableID, prefixListID string) (*ec2.TransitGatewayPrefixListReference, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayPrefixListReferenceStateDeleting},
+		Target:  []string{},
+		Refresh: TransitGatewayPrefixListReferenceState(conn, transitGatewayRouteTableID, prefixListID),
+		Timeout: TransitGatewayPrefixListReferenceTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayPrefixListReference); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func TransitGatewayPrefixListReferenceStateUpdated(conn *ec2.EC2, transitGatewayRouteTableID, prefixListID string) (*ec2.TransitGatewayPrefixListReference, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayPrefixListReferenceStateModifying},
+		Target:  []string{ec2.TransitGatewayPrefixListReferenceStateAvailable},
+		Refresh: TransitGatewayPrefixListReferenceState(conn, transitGatewayRouteTableID, prefixListID),
+		Timeout: TransitGatewayPrefixListReferenceTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayPrefixListReference); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,112 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+)
+
+// GlobalReplicationGroupByID returns the ElastiCache Global Replication Group corresponding to the specified identifier.
+// Returns nil if no Global Replication Group is found.
+func GlobalReplicationGroupByID(conn *elasticache.ElastiCache, id string) (*elasticache.GlobalReplicationGroup, error) {
+	input := &elasticache.DescribeGlobalReplicationGroupsInput{
+		GlobalReplicationGroupId: aws.String(id),
+		ShowMemberInfo:           aws.Bool(true),
+	}
+
+	output, err := conn.DescribeGlobalReplicationGroups(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, globalReplicationGroup := range output.GlobalReplicationGroups {
+		if globalReplicationGroup == nil {
+			continue
+		}
+
+		if aws.StringValue(globalReplicationGroup.GlobalReplicationGroupId) == id {
+			return globalReplicationGroup, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// GlobalReplicationGroupMemberByID returns the member of the specified ElastiCache Global Replication Group
+// corresponding to the specified Replication Group identifier.
+// Returns nil if no Global Replication Group or member is found.
+func GlobalReplicationGroupMemberByID(conn *elasticache.ElastiCache, globalReplicationGroupID, replicationGroupID string) (*elasticache.GlobalReplicationGroupMember, error) {
+	globalReplicationGroup, err := GlobalReplicationGroupByID(conn, globalReplicationGroupID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if globalReplicationGroup == nil {
+		return nil, nil
+	}
+
+	for _, member := range globalReplicationGroup.Members {
+		if member == nil {
+			continue
+		}
+
+		if aws.StringValue(member.ReplicationGroupId) == replicationGroupID {
+			return member, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// UserByID returns the ElastiCache User corresponding to the specified identifier.
+// Returns nil if no User is found.
+func UserByID(conn *elasticache.ElastiCache, id string) (*elasticache.User, error) {
+	input := &elasticache.DescribeUsersInput{
+		UserId: aws.String(id),
+	}
+
+	output, err := conn.DescribeUsers(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range output.Users {
+		if user == nil {
+			continue
+		}
+
+		if aws.StringValue(user.UserId) == id {
+			return user, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// UserGroupByID returns the ElastiCache User Group corresponding to the specified identifier.
+// Returns nil if no User Group is found.
+func UserGroupByID(conn *elasticache.ElastiCache, id string) (*elasticache.UserGroup, error) {
+	input := &elasticache.DescribeUserGroupsInput{
+		UserGroupId: aws.String(id),
+	}
+
+	output, err := conn.DescribeUserGroups(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, userGroup := range output.UserGroups {
+		if userGroup == nil {
+			continue
+		}
+
+		if aws.StringValue(userGroup.UserGroupId) == id {
+			return userGroup, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,135 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
+)
+
+const (
+	GlobalReplicationGroupStatusAvailable   = "available"
+	GlobalReplicationGroupStatusCreating    = "creating"
+	GlobalReplicationGroupStatusDeleting    = "deleting"
+	GlobalReplicationGroupStatusModifying   = "modifying"
+	GlobalReplicationGroupStatusPrimaryOnly = "primary-only"
+
+	GlobalReplicationGroupMemberStatusAssociated = "associated"
+
+	GlobalReplicationGroupMemberRolePrimary = "PRIMARY"
+
+	UserStatusActive    = "active"
+	UserStatusCreating  = "creating"
+	UserStatusDeleting  = "deleting"
+	UserStatusModifying = "modifying"
+
+	UserGroupStatusActive    = "active"
+	UserGroupStatusCreating  = "creating"
+	UserGroupStatusDeleting  = "deleting"
+	UserGroupStatusModifying = "modifying"
+)
+
+const (
+	globalReplicationGroupStatusNotFound = "NotFound"
+	globalReplicationGroupStatusUnknown  = "Unknown"
+)
+
+// GlobalReplicationGroupStatus fetches the Global Replication Group and its Status
+func GlobalReplicationGroupStatus(conn *elasticache.ElastiCache, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		globalReplicationGroup, err := finder.GlobalReplicationGroupByID(conn, id)
+
+		if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+			return nil, globalReplicationGroupStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, globalReplicationGroupStatusUnknown, err
+		}
+
+		if globalReplicationGroup == nil {
+			return nil, globalReplicationGroupStatusNotFound, nil
+		}
+
+		return globalReplicationGroup, aws.StringValue(globalReplicationGroup.Status), nil
+	}
+}
+
+const (
+	globalReplicationGroupMemberStatusNotFound = "NotFound"
+	globalReplicationGroupMemberStatusUnknown  = "Unknown"
+)
+
+// GlobalReplicationGroupMemberStatus fetches the Global Replication Group member and its Status
+func GlobalReplicationGroupMemberStatus(conn *elasticache.ElastiCache, globalReplicationGroupID, replicationGroupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		member, err := finder.GlobalReplicationGroupMemberByID(conn, globalReplicationGroupID, replicationGroupID)
+
+		if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+			return nil, globalReplicationGroupMemberStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, globalReplicationGroupMemberStatusUnknown, err
+		}
+
+		if member == nil {
+			return nil, globalReplicationGroupMemberStatusNotFound, nil
+		}
+
+		return member, aws.StringValue(member.Status), nil
+	}
+}
+
+const (
+	userStatusNotFound = "NotFound"
+	userStatusUnknown  = "Unknown"
+)
+
+// UserStatus fetches the User and its Status
+func UserStatus(conn *elasticache.ElastiCache, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		user, err := finder.UserByID(conn, id)
+
+		if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeUserNotFoundFault) {
+			return nil, userStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, userStatusUnknown, err
+		}
+
+		if user == nil {
+			return nil, userStatusNotFound, nil
+		}
+
+		return user, aws.StringValue(user.Status), nil
+	}
+}
+
+const (
+	userGroupStatusNotFound = "NotFound"
+	userGroupStatusUnknown  = "Unknown"
+)
+
+// UserGroupStatus fetches the User Group and its Status
+func UserGroupStatus(conn *elasticache.ElastiCache, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		userGroup, err := finder.UserGroupByID(conn, id)
+
+		if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeUserGroupNotFoundFault) {
+			return nil, userGroupStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, userGroupStatusUnknown, err
+		}
+
+		if userGroup == nil {
+			return nil, userGroupStatusNotFound, nil
+		}
+
+		return userGroup, aws.StringValue(userGroup.Status), nil
+	}
+}
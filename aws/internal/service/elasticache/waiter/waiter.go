@@ -0,0 +1,175 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Global Replication Group to become available
+	GlobalReplicationGroupAvailableTimeout = 60 * time.Minute
+
+	// Maximum amount of time to wait for a Global Replication Group to be deleted
+	GlobalReplicationGroupDeletedTimeout = 20 * time.Minute
+
+	// Maximum amount of time to wait for a Replication Group to leave a Global Replication Group
+	GlobalReplicationGroupMemberDetachedTimeout = 20 * time.Minute
+
+	globalReplicationGroupDelay      = 30 * time.Second
+	globalReplicationGroupMinTimeout = 10 * time.Second
+)
+
+// GlobalReplicationGroupAvailable waits for a Global Replication Group to be available
+func GlobalReplicationGroupAvailable(conn *elasticache.ElastiCache, id string, timeout time.Duration) (*elasticache.GlobalReplicationGroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{GlobalReplicationGroupStatusCreating, GlobalReplicationGroupStatusModifying},
+		Target:     []string{GlobalReplicationGroupStatusAvailable, GlobalReplicationGroupStatusPrimaryOnly},
+		Refresh:    GlobalReplicationGroupStatus(conn, id),
+		Timeout:    timeout,
+		Delay:      globalReplicationGroupDelay,
+		MinTimeout: globalReplicationGroupMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroup); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// GlobalReplicationGroupDeleted waits for a Global Replication Group to be deleted
+func GlobalReplicationGroupDeleted(conn *elasticache.ElastiCache, id string) (*elasticache.GlobalReplicationGroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			GlobalReplicationGroupStatusAvailable,
+			GlobalReplicationGroupStatusPrimaryOnly,
+			GlobalReplicationGroupStatusModifying,
+			GlobalReplicationGroupStatusDeleting,
+		},
+		Target:     []string{},
+		Refresh:    GlobalReplicationGroupStatus(conn, id),
+		Timeout:    GlobalReplicationGroupDeletedTimeout,
+		Delay:      globalReplicationGroupDelay,
+		MinTimeout: globalReplicationGroupMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroup); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// GlobalReplicationGroupMemberDetached waits for a Replication Group to be removed from a Global Replication Group
+func GlobalReplicationGroupMemberDetached(conn *elasticache.ElastiCache, globalReplicationGroupID, replicationGroupID string) (*elasticache.GlobalReplicationGroupMember, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{GlobalReplicationGroupMemberStatusAssociated},
+		Target:     []string{},
+		Refresh:    GlobalReplicationGroupMemberStatus(conn, globalReplicationGroupID, replicationGroupID),
+		Timeout:    GlobalReplicationGroupMemberDetachedTimeout,
+		Delay:      globalReplicationGroupDelay,
+		MinTimeout: globalReplicationGroupMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroupMember); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+const (
+	// Maximum amount of time to wait for a User to return active
+	UserActiveTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a User to be deleted
+	UserDeletedTimeout = 5 * time.Minute
+)
+
+// UserActive waits for a User to return active
+func UserActive(conn *elasticache.ElastiCache, id string) (*elasticache.User, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{UserStatusCreating, UserStatusModifying},
+		Target:  []string{UserStatusActive},
+		Refresh: UserStatus(conn, id),
+		Timeout: UserActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*elasticache.User); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// UserDeleted waits for a User to be deleted
+func UserDeleted(conn *elasticache.ElastiCache, id string) (*elasticache.User, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{UserStatusActive, UserStatusDeleting},
+		Target:  []string{},
+		Refresh: UserStatus(conn, id),
+		Timeout: UserDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*elasticache.User); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+const (
+	// Maximum amount of time to wait for a User Group to return active
+	UserGroupActiveTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a User Group to be deleted
+	UserGroupDeletedTimeout = 10 * time.Minute
+)
+
+// UserGroupActive waits for a User Group to return active
+func UserGroupActive(conn *elasticache.ElastiCache, id string) (*elasticache.UserGroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{UserGroupStatusCreating, UserGroupStatusModifying},
+		Target:  []string{UserGroupStatusActive},
+		Refresh: UserGroupStatus(conn, id),
+		Timeout: UserGroupActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*elasticache.UserGroup); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// UserGroupDeleted waits for a User Group to be deleted
+func UserGroupDeleted(conn *elasticache.ElastiCache, id string) (*elasticache.UserGroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{UserGroupStatusActive, UserGroupStatusDeleting},
+		Target:  []string{},
+		Refresh: UserGroupStatus(conn, id),
+		Timeout: UserGroupDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*elasticache.UserGroup); ok {
+		return v, err
+	}
+
+	return nil, err
+}
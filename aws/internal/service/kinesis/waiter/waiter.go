@@ -0,0 +1,52 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a StreamConsumer to return ACTIVE
+	StreamConsumerCreatedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a StreamConsumer to be deleted
+	StreamConsumerDeletedTimeout = 5 * time.Minute
+)
+
+// StreamConsumerCreated waits for a StreamConsumer to return ACTIVE
+func StreamConsumerCreated(conn *kinesis.Kinesis, arn string) (*kinesis.ConsumerDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kinesis.ConsumerStatusCreating},
+		Target:  []string{kinesis.ConsumerStatusActive},
+		Refresh: StreamConsumerStatus(conn, arn),
+		Timeout: StreamConsumerCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*kinesis.ConsumerDescription); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// StreamConsumerDeleted waits for a StreamConsumer to be deleted
+func StreamConsumerDeleted(conn *kinesis.Kinesis, arn string) (*kinesis.ConsumerDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kinesis.ConsumerStatusDeleting},
+		Target:  []string{},
+		Refresh: StreamConsumerStatus(conn, arn),
+		Timeout: StreamConsumerDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*kinesis.ConsumerDescription); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,108 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+)
+
+// PortfolioShare returns the share of a portfolio with the specified principal.
+// Returns nil if no matching share is found.
+func PortfolioShare(conn *servicecatalog.ServiceCatalog, portfolioID, shareType, principalID string) (*servicecatalog.PortfolioShareDetail, error) {
+	input := &servicecatalog.DescribePortfolioSharesInput{
+		PortfolioId: aws.String(portfolioID),
+		Type:        aws.String(shareType),
+	}
+
+	var result *servicecatalog.PortfolioShareDetail
+
+	err := conn.DescribePortfolioSharesPages(input, func(page *servicecatalog.DescribePortfolioSharesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, share := range page.PortfolioShareDetails {
+			if share == nil {
+				continue
+			}
+
+			if aws.StringValue(share.PrincipalId) == principalID {
+				result = share
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+// PrincipalPortfolioAssociation returns the principal associated with a portfolio.
+// Returns nil if no matching principal is found.
+func PrincipalPortfolioAssociation(conn *servicecatalog.ServiceCatalog, acceptLanguage, portfolioID, principalARN string) (*servicecatalog.Principal, error) {
+	input := &servicecatalog.ListPrincipalsForPortfolioInput{
+		PortfolioId: aws.String(portfolioID),
+	}
+
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	var result *servicecatalog.Principal
+
+	err := conn.ListPrincipalsForPortfolioPages(input, func(page *servicecatalog.ListPrincipalsForPortfolioOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, principal := range page.Principals {
+			if principal == nil {
+				continue
+			}
+
+			if aws.StringValue(principal.PrincipalARN) == principalARN {
+				result = principal
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+// ProductPortfolioAssociation returns the portfolio associated with a product.
+// Returns nil if no matching portfolio is found.
+func ProductPortfolioAssociation(conn *servicecatalog.ServiceCatalog, acceptLanguage, portfolioID, productID string) (*servicecatalog.PortfolioDetail, error) {
+	input := &servicecatalog.ListPortfoliosForProductInput{
+		ProductId: aws.String(productID),
+	}
+
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	var result *servicecatalog.PortfolioDetail
+
+	err := conn.ListPortfoliosForProductPages(input, func(page *servicecatalog.ListPortfoliosForProductOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, portfolio := range page.PortfolioDetails {
+			if portfolio == nil {
+				continue
+			}
+
+			if aws.StringValue(portfolio.Id) == portfolioID {
+				result = portfolio
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
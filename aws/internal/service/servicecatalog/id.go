@@ -0,0 +1,67 @@
+package servicecatalog
+
+import (
+	"fmt"
+	"strings"
+)
+
+const productPortfolioAssociationIDSeparator = ":"
+
+func ProductPortfolioAssociationCreateID(portfolioID, productID string) string {
+	return portfolioID + productPortfolioAssociationIDSeparator + productID
+}
+
+func ProductPortfolioAssociationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, productPortfolioAssociationIDSeparator)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%q), expected <portfolio-id>"+productPortfolioAssociationIDSeparator+"<product-id>", id)
+}
+
+// Principal ARNs contain ":" so a different separator is used.
+const principalPortfolioAssociationIDSeparator = ","
+
+func PrincipalPortfolioAssociationCreateID(portfolioID, principalARN string) string {
+	return portfolioID + principalPortfolioAssociationIDSeparator + principalARN
+}
+
+func PrincipalPortfolioAssociationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, principalPortfolioAssociationIDSeparator)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%q), expected <portfolio-id>"+principalPortfolioAssociationIDSeparator+"<principal-arn>", id)
+}
+
+const provisioningArtifactIDSeparator = ":"
+
+func ProvisioningArtifactCreateID(productID, provisioningArtifactID string) string {
+	return productID + provisioningArtifactIDSeparator + provisioningArtifactID
+}
+
+func ProvisioningArtifactParseID(id string) (string, string, error) {
+	parts := strings.Split(id, provisioningArtifactIDSeparator)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%q), expected <product-id>"+provisioningArtifactIDSeparator+"<provisioning-artifact-id>", id)
+}
+
+const portfolioShareIDSeparator = ":"
+
+func PortfolioShareCreateID(portfolioID, shareType, principalID string) string {
+	return strings.Join([]string{portfolioID, shareType, principalID}, portfolioShareIDSeparator)
+}
+
+func PortfolioShareParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, portfolioShareIDSeparator)
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%q), expected <portfolio-id>"+portfolioShareIDSeparator+"<type>"+portfolioShareIDSeparator+"<principal-id>", id)
+}
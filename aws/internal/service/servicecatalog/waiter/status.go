@@ -0,0 +1,174 @@
+package waiter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	StatusNotFound = "NotFound"
+	StatusUnknown  = "Unknown"
+)
+
+// ProductStatus fetches the Product and its Status
+func ProductStatus(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &servicecatalog.DescribeProductAsAdminInput{
+			AcceptLanguage: aws.String(acceptLanguage),
+			Id:             aws.String(id),
+		}
+
+		output, err := conn.DescribeProductAsAdmin(input)
+
+		if err != nil {
+			return nil, StatusUnknown, err
+		}
+
+		if output == nil || output.ProductViewDetail == nil {
+			return nil, StatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.ProductViewDetail.Status), nil
+	}
+}
+
+// ProvisioningArtifactStatus fetches the Provisioning Artifact and its Status
+func ProvisioningArtifactStatus(conn *servicecatalog.ServiceCatalog, acceptLanguage, productID, provisioningArtifactID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &servicecatalog.DescribeProvisioningArtifactInput{
+			AcceptLanguage:         aws.String(acceptLanguage),
+			ProductId:              aws.String(productID),
+			ProvisioningArtifactId: aws.String(provisioningArtifactID),
+		}
+
+		output, err := conn.DescribeProvisioningArtifact(input)
+
+		if err != nil {
+			return nil, StatusUnknown, err
+		}
+
+		if output == nil || output.ProvisioningArtifactDetail == nil {
+			return nil, StatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// ConstraintStatus fetches the Constraint and its Status
+func ConstraintStatus(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &servicecatalog.DescribeConstraintInput{
+			AcceptLanguage: aws.String(acceptLanguage),
+			Id:             aws.String(id),
+		}
+
+		output, err := conn.DescribeConstraint(input)
+
+		if err != nil {
+			return nil, StatusUnknown, err
+		}
+
+		if output == nil || output.ConstraintDetail == nil {
+			return nil, StatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// PortfolioShareStatus fetches the status of an organization Portfolio Share operation
+func PortfolioShareStatus(conn *servicecatalog.ServiceCatalog, token string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &servicecatalog.DescribePortfolioShareStatusInput{
+			PortfolioShareToken: aws.String(token),
+		}
+
+		output, err := conn.DescribePortfolioShareStatus(input)
+
+		if err != nil {
+			return nil, StatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, StatusNotFound, nil
+		}
+
+		status := aws.StringValue(output.Status)
+
+		if status == servicecatalog.ShareStatusCompletedWithErrors || status == servicecatalog.ShareStatusError {
+			return output, status, portfolioShareError(output.ShareDetails)
+		}
+
+		return output, status, nil
+	}
+}
+
+// RecordStatus fetches the Record of a provisioned product operation and its Status
+func RecordStatus(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &servicecatalog.DescribeRecordInput{
+			AcceptLanguage: aws.String(acceptLanguage),
+			Id:             aws.String(id),
+		}
+
+		output, err := conn.DescribeRecord(input)
+
+		if err != nil {
+			return nil, StatusUnknown, err
+		}
+
+		if output == nil || output.RecordDetail == nil {
+			return nil, StatusNotFound, nil
+		}
+
+		status := aws.StringValue(output.RecordDetail.Status)
+
+		if status == servicecatalog.RecordStatusFailed {
+			return output, status, recordError(output.RecordDetail.RecordErrors)
+		}
+
+		return output, status, nil
+	}
+}
+
+func portfolioShareError(details *servicecatalog.ShareDetails) error {
+	if details == nil || len(details.ShareErrors) == 0 {
+		return errors.New("portfolio share failed")
+	}
+
+	var messages []string
+
+	for _, shareError := range details.ShareErrors {
+		if shareError == nil {
+			continue
+		}
+
+		messages = append(messages, fmt.Sprintf("%s: %s (%s)", aws.StringValue(shareError.Error), aws.StringValue(shareError.Message), strings.Join(aws.StringValueSlice(shareError.Accounts), ", ")))
+	}
+
+	return errors.New(strings.Join(messages, "; "))
+}
+
+func recordError(recordErrors []*servicecatalog.RecordError) error {
+	if len(recordErrors) == 0 {
+		return errors.New("record failed")
+	}
+
+	var messages []string
+
+	for _, recordError := range recordErrors {
+		if recordError == nil {
+			continue
+		}
+
+		messages = append(messages, fmt.Sprintf("%s: %s", aws.StringValue(recordError.Code), aws.StringValue(recordError.Description)))
+	}
+
+	return errors.New(strings.Join(messages, "; "))
+}
@@ -0,0 +1,112 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Product to become AVAILABLE
+	ProductReadyTimeout = 3 * time.Minute
+
+	// Maximum amount of time to wait for a Provisioning Artifact to become AVAILABLE
+	ProvisioningArtifactReadyTimeout = 3 * time.Minute
+
+	// Maximum amount of time to wait for a Constraint to become AVAILABLE
+	ConstraintReadyTimeout = 3 * time.Minute
+
+	// Maximum amount of time to wait for an organization Portfolio Share operation to complete
+	PortfolioShareReadyTimeout = 3 * time.Minute
+)
+
+// ProductReady waits for a Product to return AVAILABLE
+func ProductReady(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string) (*servicecatalog.DescribeProductAsAdminOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicecatalog.StatusCreating, StatusNotFound},
+		Target:  []string{servicecatalog.StatusAvailable},
+		Refresh: ProductStatus(conn, acceptLanguage, id),
+		Timeout: ProductReadyTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*servicecatalog.DescribeProductAsAdminOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// ProvisioningArtifactReady waits for a Provisioning Artifact to return AVAILABLE
+func ProvisioningArtifactReady(conn *servicecatalog.ServiceCatalog, acceptLanguage, productID, provisioningArtifactID string) (*servicecatalog.DescribeProvisioningArtifactOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicecatalog.StatusCreating, StatusNotFound},
+		Target:  []string{servicecatalog.StatusAvailable},
+		Refresh: ProvisioningArtifactStatus(conn, acceptLanguage, productID, provisioningArtifactID),
+		Timeout: ProvisioningArtifactReadyTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*servicecatalog.DescribeProvisioningArtifactOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// ConstraintReady waits for a Constraint to return AVAILABLE
+func ConstraintReady(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string) (*servicecatalog.DescribeConstraintOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicecatalog.StatusCreating, StatusNotFound},
+		Target:  []string{servicecatalog.StatusAvailable},
+		Refresh: ConstraintStatus(conn, acceptLanguage, id),
+		Timeout: ConstraintReadyTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*servicecatalog.DescribeConstraintOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// PortfolioShareReady waits for an organization Portfolio Share operation to return COMPLETED
+func PortfolioShareReady(conn *servicecatalog.ServiceCatalog, token string) (*servicecatalog.DescribePortfolioShareStatusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicecatalog.ShareStatusNotStarted, servicecatalog.ShareStatusInProgress},
+		Target:  []string{servicecatalog.ShareStatusCompleted},
+		Refresh: PortfolioShareStatus(conn, token),
+		Timeout: PortfolioShareReadyTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*servicecatalog.DescribePortfolioShareStatusOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// RecordReady waits for the Record of a provisioned product operation to return SUCCEEDED
+func RecordReady(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string, timeout time.Duration) (*servicecatalog.DescribeRecordOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicecatalog.RecordStatusCreated, servicecatalog.RecordStatusInProgress, servicecatalog.RecordStatusInProgressInError},
+		Target:  []string{servicecatalog.RecordStatusSucceeded},
+		Refresh: RecordStatus(conn, acceptLanguage, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*servicecatalog.DescribeRecordOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,31 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+)
+
+// DedicatedIpPoolByName returns the name of the dedicated IP pool if it exists.
+// Returns an empty string if no matching pool is found.
+func DedicatedIpPoolByName(conn *sesv2.SESV2, name string) (string, error) {
+	input := &sesv2.ListDedicatedIpPoolsInput{}
+
+	var result string
+
+	err := conn.ListDedicatedIpPoolsPages(input, func(page *sesv2.ListDedicatedIpPoolsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, pool := range page.DedicatedIpPools {
+			if aws.StringValue(pool) == name {
+				result = name
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
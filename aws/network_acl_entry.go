@@ -1,11 +1,14 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func expandNetworkAclEntries(configured []interface{}, entryType string) ([]*ec2.NetworkAclEntry, error) {
@@ -57,6 +60,82 @@ func expandNetworkAclEntries(configured []interface{}, entryType string) ([]*ec2
 	return entries, nil
 }
 
+// networkAclRuleNumberRange is an inclusive range of Network ACL rule numbers
+// a resource is authoritative over. A nil range covers all rule numbers.
+type networkAclRuleNumberRange struct {
+	from int64
+	to   int64
+}
+
+func (r *networkAclRuleNumberRange) contains(ruleNumber int64) bool {
+	if r == nil {
+		return true
+	}
+
+	return ruleNumber >= r.from && ruleNumber <= r.to
+}
+
+func networkAclManagedRuleNumberRangeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"from": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 32766),
+				},
+				"to": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 32766),
+				},
+			},
+		},
+	}
+}
+
+func expandNetworkAclRuleNumberRange(tfList []interface{}) *networkAclRuleNumberRange {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &networkAclRuleNumberRange{
+		from: int64(tfMap["from"].(int)),
+		to:   int64(tfMap["to"].(int)),
+	}
+}
+
+// networkAclManagedRuleNumberRangeCustomizeDiff ensures that all in-line
+// entries fall within the managed rule number range, if one is configured.
+func networkAclManagedRuleNumberRangeCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	ruleNumbers := expandNetworkAclRuleNumberRange(diff.Get("managed_rule_number_range").([]interface{}))
+
+	if ruleNumbers == nil {
+		return nil
+	}
+
+	if ruleNumbers.from > ruleNumbers.to {
+		return fmt.Errorf("managed_rule_number_range: from (%d) must not be greater than to (%d)", ruleNumbers.from, ruleNumbers.to)
+	}
+
+	for _, entryType := range []string{"ingress", "egress"} {
+		for _, tfMapRaw := range diff.Get(entryType).(*schema.Set).List() {
+			ruleNumber := int64(tfMapRaw.(map[string]interface{})["rule_no"].(int))
+
+			if !ruleNumbers.contains(ruleNumber) {
+				return fmt.Errorf("%s rule number (%d) is outside of managed_rule_number_range (%d-%d)", entryType, ruleNumber, ruleNumbers.from, ruleNumbers.to)
+			}
+		}
+	}
+
+	return nil
+}
+
 func protocolStrings(protocolIntegers map[string]int) map[int]string {
 	protocolStrings := make(map[int]string, len(protocolIntegers))
 	for k, v := range protocolIntegers {
@@ -98,3 +98,36 @@ func Test_validatePorts(t *testing.T) {
 		}
 	}
 }
+
+func Test_expandNetworkAclRuleNumberRange(t *testing.T) {
+	if r := expandNetworkAclRuleNumberRange(nil); r != nil {
+		t.Fatalf("expected nil range, got %#v", r)
+	}
+
+	var all *networkAclRuleNumberRange
+
+	if !all.contains(1) || !all.contains(32766) {
+		t.Fatal("expected nil range to contain all rule numbers")
+	}
+
+	r := expandNetworkAclRuleNumberRange([]interface{}{
+		map[string]interface{}{
+			"from": 100,
+			"to":   199,
+		},
+	})
+
+	cases := map[int64]bool{
+		99:  false,
+		100: true,
+		150: true,
+		199: true,
+		200: false,
+	}
+
+	for ruleNumber, expected := range cases {
+		if got := r.contains(ruleNumber); got != expected {
+			t.Errorf("contains(%d): got %t, expected %t", ruleNumber, got, expected)
+		}
+	}
+}
@@ -2,10 +2,13 @@ package aws
 
 import (
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/mutexkv"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/registry"
 )
 
 // Provider returns a *schema.Provider.
@@ -39,6 +42,8 @@ func Provider() *schema.Provider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -71,6 +76,51 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"max_backoff_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  descriptions["max_backoff_seconds"],
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"retry_mode": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWS_RETRY_MODE", RetryModeStandard),
+				Description: descriptions["retry_mode"],
+				ValidateFunc: validation.StringInSlice([]string{
+					RetryModeAdaptive,
+					RetryModeStandard,
+				}, false),
+			},
+
+			"rate_limit": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: descriptions["rate_limit"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "Maximum number of requests sent at once.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							Description:  "Maximum average number of requests sent per second.",
+							ValidateFunc: validation.FloatAtLeast(0.1),
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Endpoint prefix of the AWS service, e.g. `ec2`.",
+						},
+					},
+				},
+			},
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -121,6 +171,41 @@ func Provider() *schema.Provider {
 				Description: descriptions["insecure"],
 			},
 
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWS_CA_BUNDLE", ""),
+				Description: descriptions["custom_ca_bundle"],
+			},
+
+			"http_proxy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"HTTPS_PROXY",
+					"HTTP_PROXY",
+				}, ""),
+				Description: descriptions["http_proxy"],
+			},
+
+			"ec2_metadata_service_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWS_EC2_METADATA_SERVICE_ENDPOINT", ""),
+				Description: descriptions["ec2_metadata_service_endpoint"],
+			},
+
+			"ec2_metadata_service_endpoint_mode": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE", EC2MetadataServiceEndpointModeIPv4),
+				Description: descriptions["ec2_metadata_service_endpoint_mode"],
+				ValidateFunc: validation.StringInSlice([]string{
+					EC2MetadataServiceEndpointModeIPv4,
+					EC2MetadataServiceEndpointModeIPv6,
+				}, false),
+			},
+
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -200,6 +285,7 @@ func Provider() *schema.Provider {
 			"aws_db_cluster_snapshot":                        dataSourceAwsDbClusterSnapshot(),
 			"aws_db_event_categories":                        dataSourceAwsDbEventCategories(),
 			"aws_db_instance":                                dataSourceAwsDbInstance(),
+			"aws_db_instances":                               dataSourceAwsDbInstances(),
 			"aws_db_snapshot":                                dataSourceAwsDbSnapshot(),
 			"aws_db_subnet_group":                            dataSourceAwsDbSubnetGroup(),
 			"aws_directory_service_directory":                dataSourceAwsDirectoryServiceDirectory(),
@@ -215,6 +301,7 @@ func Provider() *schema.Provider {
 			"aws_ebs_volumes":                                dataSourceAwsEbsVolumes(),
 			"aws_ec2_coip_pool":                              dataSourceAwsEc2CoipPool(),
 			"aws_ec2_coip_pools":                             dataSourceAwsEc2CoipPools(),
+			"aws_ec2_host":                                   dataSourceAwsEc2Host(),
 			"aws_ec2_instance_type":                          dataSourceAwsEc2InstanceType(),
 			"aws_ec2_instance_type_offering":                 dataSourceAwsEc2InstanceTypeOffering(),
 			"aws_ec2_instance_type_offerings":                dataSourceAwsEc2InstanceTypeOfferings(),
@@ -237,8 +324,10 @@ func Provider() *schema.Provider {
 			"aws_ecr_image":                                  dataSourceAwsEcrImage(),
 			"aws_ecr_repository":                             dataSourceAwsEcrRepository(),
 			"aws_ecs_cluster":                                dataSourceAwsEcsCluster(),
+			"aws_ecs_clusters":                               dataSourceAwsEcsClusters(),
 			"aws_ecs_container_definition":                   dataSourceAwsEcsContainerDefinition(),
 			"aws_ecs_service":                                dataSourceAwsEcsService(),
+			"aws_ecs_services":                               dataSourceAwsEcsServices(),
 			"aws_ecs_task_definition":                        dataSourceAwsEcsTaskDefinition(),
 			"aws_customer_gateway":                           dataSourceAwsCustomerGateway(),
 			"aws_efs_access_point":                           dataSourceAwsEfsAccessPoint(),
@@ -279,6 +368,7 @@ func Provider() *schema.Provider {
 			"aws_iot_endpoint":                               dataSourceAwsIotEndpoint(),
 			"aws_ip_ranges":                                  dataSourceAwsIPRanges(),
 			"aws_kinesis_stream":                             dataSourceAwsKinesisStream(),
+			"aws_kinesis_stream_consumer":                    dataSourceAwsKinesisStreamConsumer(),
 			"aws_kms_alias":                                  dataSourceAwsKmsAlias(),
 			"aws_kms_ciphertext":                             dataSourceAwsKmsCiphertext(),
 			"aws_kms_key":                                    dataSourceAwsKmsKey(),
@@ -290,6 +380,7 @@ func Provider() *schema.Provider {
 			"aws_lambda_alias":                               dataSourceAwsLambdaAlias(),
 			"aws_lambda_code_signing_config":                 dataSourceAwsLambdaCodeSigningConfig(),
 			"aws_lambda_function":                            dataSourceAwsLambdaFunction(),
+			"aws_lambda_functions":                           dataSourceAwsLambdaFunctions(),
 			"aws_lambda_invocation":                          dataSourceAwsLambdaInvocation(),
 			"aws_lambda_layer_version":                       dataSourceAwsLambdaLayerVersion(),
 			"aws_launch_configuration":                       dataSourceAwsLaunchConfiguration(),
@@ -307,6 +398,7 @@ func Provider() *schema.Provider {
 			"aws_network_acls":                               dataSourceAwsNetworkAcls(),
 			"aws_network_interface":                          dataSourceAwsNetworkInterface(),
 			"aws_network_interfaces":                         dataSourceAwsNetworkInterfaces(),
+			"aws_organizations_delegated_services":           dataSourceAwsOrganizationsDelegatedServices(),
 			"aws_organizations_organization":                 dataSourceAwsOrganizationsOrganization(),
 			"aws_organizations_organizational_units":         dataSourceAwsOrganizationsOrganizationalUnits(),
 			"aws_outposts_outpost":                           dataSourceAwsOutpostsOutpost(),
@@ -322,6 +414,7 @@ func Provider() *schema.Provider {
 			"aws_ram_resource_share":                         dataSourceAwsRamResourceShare(),
 			"aws_rds_certificate":                            dataSourceAwsRdsCertificate(),
 			"aws_rds_cluster":                                dataSourceAwsRdsCluster(),
+			"aws_rds_clusters":                               dataSourceAwsRdsClusters(),
 			"aws_rds_engine_version":                         dataSourceAwsRdsEngineVersion(),
 			"aws_rds_orderable_db_instance":                  dataSourceAwsRdsOrderableDbInstance(),
 			"aws_redshift_cluster":                           dataSourceAwsRedshiftCluster(),
@@ -329,6 +422,7 @@ func Provider() *schema.Provider {
 			"aws_redshift_service_account":                   dataSourceAwsRedshiftServiceAccount(),
 			"aws_region":                                     dataSourceAwsRegion(),
 			"aws_regions":                                    dataSourceAwsRegions(),
+			"aws_resourcegroupstaggingapi_resources":         dataSourceAwsResourceGroupsTaggingAPIResources(),
 			"aws_route":                                      dataSourceAwsRoute(),
 			"aws_route_table":                                dataSourceAwsRouteTable(),
 			"aws_route_tables":                               dataSourceAwsRouteTables(),
@@ -453,6 +547,7 @@ func Provider() *schema.Provider {
 			"aws_appmesh_virtual_node":                                resourceAwsAppmeshVirtualNode(),
 			"aws_appmesh_virtual_router":                              resourceAwsAppmeshVirtualRouter(),
 			"aws_appmesh_virtual_service":                             resourceAwsAppmeshVirtualService(),
+			"aws_appsync_api_cache":                                   resourceAwsAppsyncApiCache(),
 			"aws_appsync_api_key":                                     resourceAwsAppsyncApiKey(),
 			"aws_appsync_datasource":                                  resourceAwsAppsyncDatasource(),
 			"aws_appsync_function":                                    resourceAwsAppsyncFunction(),
@@ -468,6 +563,7 @@ func Provider() *schema.Provider {
 			"aws_autoscaling_policy":                                  resourceAwsAutoscalingPolicy(),
 			"aws_autoscaling_schedule":                                resourceAwsAutoscalingSchedule(),
 			"aws_autoscalingplans_scaling_plan":                       resourceAwsAutoScalingPlansScalingPlan(),
+			"aws_backup_global_settings":                              resourceAwsBackupGlobalSettings(),
 			"aws_backup_plan":                                         resourceAwsBackupPlan(),
 			"aws_backup_region_settings":                              resourceAwsBackupRegionSettings(),
 			"aws_backup_selection":                                    resourceAwsBackupSelection(),
@@ -496,23 +592,29 @@ func Provider() *schema.Provider {
 			"aws_cloudwatch_log_subscription_filter":                  resourceAwsCloudwatchLogSubscriptionFilter(),
 			"aws_config_aggregate_authorization":                      resourceAwsConfigAggregateAuthorization(),
 			"aws_config_config_rule":                                  resourceAwsConfigConfigRule(),
+			"aws_config_conformance_pack":                             resourceAwsConfigConformancePack(),
 			"aws_config_configuration_aggregator":                     resourceAwsConfigConfigurationAggregator(),
 			"aws_config_configuration_recorder":                       resourceAwsConfigConfigurationRecorder(),
 			"aws_config_configuration_recorder_status":                resourceAwsConfigConfigurationRecorderStatus(),
 			"aws_config_delivery_channel":                             resourceAwsConfigDeliveryChannel(),
+			"aws_config_organization_conformance_pack":                resourceAwsConfigOrganizationConformancePack(),
 			"aws_config_organization_custom_rule":                     resourceAwsConfigOrganizationCustomRule(),
 			"aws_config_organization_managed_rule":                    resourceAwsConfigOrganizationManagedRule(),
 			"aws_config_remediation_configuration":                    resourceAwsConfigRemediationConfiguration(),
 			"aws_cognito_identity_pool":                               resourceAwsCognitoIdentityPool(),
 			"aws_cognito_identity_pool_roles_attachment":              resourceAwsCognitoIdentityPoolRolesAttachment(),
 			"aws_cognito_identity_provider":                           resourceAwsCognitoIdentityProvider(),
+			"aws_cognito_user":                                        resourceAwsCognitoUser(),
 			"aws_cognito_user_group":                                  resourceAwsCognitoUserGroup(),
+			"aws_cognito_user_in_group":                               resourceAwsCognitoUserInGroup(),
 			"aws_cognito_user_pool":                                   resourceAwsCognitoUserPool(),
 			"aws_cognito_user_pool_client":                            resourceAwsCognitoUserPoolClient(),
 			"aws_cognito_user_pool_domain":                            resourceAwsCognitoUserPoolDomain(),
+			"aws_cognito_user_pool_ui_customization":                  resourceAwsCognitoUserPoolUICustomization(),
 			"aws_cloudhsm_v2_cluster":                                 resourceAwsCloudHsmV2Cluster(),
 			"aws_cloudhsm_v2_hsm":                                     resourceAwsCloudHsmV2Hsm(),
 			"aws_cognito_resource_server":                             resourceAwsCognitoResourceServer(),
+			"aws_cognito_risk_configuration":                          resourceAwsCognitoRiskConfiguration(),
 			"aws_cloudwatch_metric_alarm":                             resourceAwsCloudWatchMetricAlarm(),
 			"aws_cloudwatch_dashboard":                                resourceAwsCloudWatchDashboard(),
 			"aws_codedeploy_app":                                      resourceAwsCodeDeployApp(),
@@ -605,6 +707,7 @@ func Provider() *schema.Provider {
 			"aws_ec2_client_vpn_network_association":                  resourceAwsEc2ClientVpnNetworkAssociation(),
 			"aws_ec2_client_vpn_route":                                resourceAwsEc2ClientVpnRoute(),
 			"aws_ec2_fleet":                                           resourceAwsEc2Fleet(),
+			"aws_ec2_host":                                            resourceAwsEc2Host(),
 			"aws_ec2_local_gateway_route":                             resourceAwsEc2LocalGatewayRoute(),
 			"aws_ec2_local_gateway_route_table_vpc_association":       resourceAwsEc2LocalGatewayRouteTableVpcAssociation(),
 			"aws_ec2_managed_prefix_list":                             resourceAwsEc2ManagedPrefixList(),
@@ -614,8 +717,11 @@ func Provider() *schema.Provider {
 			"aws_ec2_traffic_mirror_target":                           resourceAwsEc2TrafficMirrorTarget(),
 			"aws_ec2_traffic_mirror_session":                          resourceAwsEc2TrafficMirrorSession(),
 			"aws_ec2_transit_gateway":                                 resourceAwsEc2TransitGateway(),
+			"aws_ec2_transit_gateway_connect":                         resourceAwsEc2TransitGatewayConnect(),
+			"aws_ec2_transit_gateway_connect_peer":                    resourceAwsEc2TransitGatewayConnectPeer(),
 			"aws_ec2_transit_gateway_peering_attachment":              resourceAwsEc2TransitGatewayPeeringAttachment(),
 			"aws_ec2_transit_gateway_peering_attachment_accepter":     resourceAwsEc2TransitGatewayPeeringAttachmentAccepter(),
+			"aws_ec2_transit_gateway_prefix_list_reference":           resourceAwsEc2TransitGatewayPrefixListReference(),
 			"aws_ec2_transit_gateway_route":                           resourceAwsEc2TransitGatewayRoute(),
 			"aws_ec2_transit_gateway_route_table":                     resourceAwsEc2TransitGatewayRouteTable(),
 			"aws_ec2_transit_gateway_route_table_association":         resourceAwsEc2TransitGatewayRouteTableAssociation(),
@@ -640,10 +746,13 @@ func Provider() *schema.Provider {
 			"aws_eks_fargate_profile":                                 resourceAwsEksFargateProfile(),
 			"aws_eks_node_group":                                      resourceAwsEksNodeGroup(),
 			"aws_elasticache_cluster":                                 resourceAwsElasticacheCluster(),
+			"aws_elasticache_global_replication_group":                resourceAwsElasticacheGlobalReplicationGroup(),
 			"aws_elasticache_parameter_group":                         resourceAwsElasticacheParameterGroup(),
 			"aws_elasticache_replication_group":                       resourceAwsElasticacheReplicationGroup(),
 			"aws_elasticache_security_group":                          resourceAwsElasticacheSecurityGroup(),
 			"aws_elasticache_subnet_group":                            resourceAwsElasticacheSubnetGroup(),
+			"aws_elasticache_user":                                    resourceAwsElasticacheUser(),
+			"aws_elasticache_user_group":                              resourceAwsElasticacheUserGroup(),
 			"aws_elastic_beanstalk_application":                       resourceAwsElasticBeanstalkApplication(),
 			"aws_elastic_beanstalk_application_version":               resourceAwsElasticBeanstalkApplicationVersion(),
 			"aws_elastic_beanstalk_configuration_template":            resourceAwsElasticBeanstalkConfigurationTemplate(),
@@ -660,6 +769,8 @@ func Provider() *schema.Provider {
 			"aws_emr_managed_scaling_policy":                          resourceAwsEMRManagedScalingPolicy(),
 			"aws_emr_security_configuration":                          resourceAwsEMRSecurityConfiguration(),
 			"aws_flow_log":                                            resourceAwsFlowLog(),
+			"aws_fsx_backup":                                          resourceAwsFsxBackup(),
+			"aws_fsx_data_repository_task":                            resourceAwsFsxDataRepositoryTask(),
 			"aws_fsx_lustre_file_system":                              resourceAwsFsxLustreFileSystem(),
 			"aws_fsx_windows_file_system":                             resourceAwsFsxWindowsFileSystem(),
 			"aws_fms_admin_account":                                   resourceAwsFmsAdminAccount(),
@@ -745,6 +856,7 @@ func Provider() *schema.Provider {
 			"aws_kinesisanalyticsv2_application":                      resourceAwsKinesisAnalyticsV2Application(),
 			"aws_kinesis_firehose_delivery_stream":                    resourceAwsKinesisFirehoseDeliveryStream(),
 			"aws_kinesis_stream":                                      resourceAwsKinesisStream(),
+			"aws_kinesis_stream_consumer":                             resourceAwsKinesisStreamConsumer(),
 			"aws_kinesis_video_stream":                                resourceAwsKinesisVideoStream(),
 			"aws_kms_alias":                                           resourceAwsKmsAlias(),
 			"aws_kms_external_key":                                    resourceAwsKmsExternalKey(),
@@ -828,6 +940,7 @@ func Provider() *schema.Provider {
 			"aws_opsworks_rds_db_instance":                            resourceAwsOpsworksRdsDbInstance(),
 			"aws_organizations_organization":                          resourceAwsOrganizationsOrganization(),
 			"aws_organizations_account":                               resourceAwsOrganizationsAccount(),
+			"aws_organizations_delegated_administrator":               resourceAwsOrganizationsDelegatedAdministrator(),
 			"aws_organizations_policy":                                resourceAwsOrganizationsPolicy(),
 			"aws_organizations_policy_attachment":                     resourceAwsOrganizationsPolicyAttachment(),
 			"aws_organizations_organizational_unit":                   resourceAwsOrganizationsOrganizationalUnit(),
@@ -895,6 +1008,9 @@ func Provider() *schema.Provider {
 			"aws_ses_event_destination":                               resourceAwsSesEventDestination(),
 			"aws_ses_identity_notification_topic":                     resourceAwsSesNotificationTopic(),
 			"aws_ses_template":                                        resourceAwsSesTemplate(),
+			"aws_sesv2_contact_list":                                  resourceAwsSesV2ContactList(),
+			"aws_sesv2_dedicated_ip_pool":                             resourceAwsSesV2DedicatedIpPool(),
+			"aws_sesv2_email_identity":                                resourceAwsSesV2EmailIdentity(),
 			"aws_s3_access_point":                                     resourceAwsS3AccessPoint(),
 			"aws_s3_account_public_access_block":                      resourceAwsS3AccountPublicAccessBlock(),
 			"aws_s3_bucket":                                           resourceAwsS3Bucket(),
@@ -913,13 +1029,23 @@ func Provider() *schema.Provider {
 			"aws_security_group":                                      resourceAwsSecurityGroup(),
 			"aws_network_interface_sg_attachment":                     resourceAwsNetworkInterfaceSGAttachment(),
 			"aws_default_security_group":                              resourceAwsDefaultSecurityGroup(),
+			"aws_security_group_egress_rule":                          resourceAwsSecurityGroupEgressRule(),
+			"aws_security_group_ingress_rule":                         resourceAwsSecurityGroupIngressRule(),
 			"aws_security_group_rule":                                 resourceAwsSecurityGroupRule(),
 			"aws_securityhub_account":                                 resourceAwsSecurityHubAccount(),
 			"aws_securityhub_action_target":                           resourceAwsSecurityHubActionTarget(),
 			"aws_securityhub_member":                                  resourceAwsSecurityHubMember(),
 			"aws_securityhub_product_subscription":                    resourceAwsSecurityHubProductSubscription(),
 			"aws_securityhub_standards_subscription":                  resourceAwsSecurityHubStandardsSubscription(),
+			"aws_servicecatalog_constraint":                           resourceAwsServiceCatalogConstraint(),
 			"aws_servicecatalog_portfolio":                            resourceAwsServiceCatalogPortfolio(),
+			"aws_servicecatalog_portfolio_share":                      resourceAwsServiceCatalogPortfolioShare(),
+			"aws_servicecatalog_principal_portfolio_association":      resourceAwsServiceCatalogPrincipalPortfolioAssociation(),
+			"aws_servicecatalog_product":                              resourceAwsServiceCatalogProduct(),
+			"aws_servicecatalog_product_portfolio_association":        resourceAwsServiceCatalogProductPortfolioAssociation(),
+			"aws_servicecatalog_provisioned_product":                  resourceAwsServiceCatalogProvisionedProduct(),
+			"aws_servicecatalog_provisioning_artifact":                resourceAwsServiceCatalogProvisioningArtifact(),
+			"aws_servicecatalog_tag_option":                           resourceAwsServiceCatalogTagOption(),
 			"aws_service_discovery_http_namespace":                    resourceAwsServiceDiscoveryHttpNamespace(),
 			"aws_service_discovery_private_dns_namespace":             resourceAwsServiceDiscoveryPrivateDnsNamespace(),
 			"aws_service_discovery_public_dns_namespace":              resourceAwsServiceDiscoveryPublicDnsNamespace(),
@@ -1110,11 +1236,33 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
+		"max_backoff_seconds": "The maximum number of seconds to wait between retries of\n" +
+			"an AWS API request. If omitted, the AWS SDK default is used.",
+
+		"retry_mode": "The retry strategy for AWS API requests. Valid values are `standard`\n" +
+			"and `adaptive`, which additionally slows down requests to a service\n" +
+			"that returns throttling errors.",
+
+		"rate_limit": "Client-side request rate limits for AWS services.",
+
 		"endpoint": "Use this to override the default service endpoint URL",
 
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
 			"default value is `false`",
 
+		"custom_ca_bundle": "File containing custom root and intermediate certificates. " +
+			"Can also be configured using the `AWS_CA_BUNDLE` environment variable.",
+
+		"http_proxy": "The address of an HTTP proxy to use when accessing the AWS API. " +
+			"Can also be configured using the `HTTP_PROXY` or `HTTPS_PROXY` environment variables.",
+
+		"ec2_metadata_service_endpoint": "Address of the EC2 metadata service endpoint to use. " +
+			"Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT` environment variable.",
+
+		"ec2_metadata_service_endpoint_mode": "Protocol to use with EC2 metadata service endpoint. " +
+			"Valid values are `IPv4` and `IPv6`. Can also be configured using the " +
+			"`AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
+
 		"skip_credentials_validation": "Skip the credentials validation via STS API. " +
 			"Used for AWS API implementations that do not have STS available/implemented.",
 
@@ -1267,6 +1415,7 @@ func init() {
 		"servicediscovery",
 		"servicequotas",
 		"ses",
+		"sesv2",
 		"shield",
 		"signer",
 		"sns",
@@ -1292,23 +1441,48 @@ func init() {
 
 func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
 	config := Config{
-		AccessKey:               d.Get("access_key").(string),
-		SecretKey:               d.Get("secret_key").(string),
-		Profile:                 d.Get("profile").(string),
-		Token:                   d.Get("token").(string),
-		Region:                  d.Get("region").(string),
-		CredsFilename:           d.Get("shared_credentials_file").(string),
-		Endpoints:               make(map[string]string),
-		MaxRetries:              d.Get("max_retries").(int),
-		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
-		Insecure:                d.Get("insecure").(bool),
-		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
-		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
-		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
-		SkipRequestingAccountId: d.Get("skip_requesting_account_id").(bool),
-		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
-		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
-		terraformVersion:        terraformVersion,
+		AccessKey:                      d.Get("access_key").(string),
+		SecretKey:                      d.Get("secret_key").(string),
+		Profile:                        d.Get("profile").(string),
+		Token:                          d.Get("token").(string),
+		Region:                         d.Get("region").(string),
+		CredsFilename:                  d.Get("shared_credentials_file").(string),
+		Endpoints:                      make(map[string]string),
+		MaxRetries:                     d.Get("max_retries").(int),
+		IgnoreTagsConfig:               expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
+		Insecure:                       d.Get("insecure").(bool),
+		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
+		HTTPProxy:                      d.Get("http_proxy").(string),
+		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
+		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
+		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
+		SkipGetEC2Platforms:            d.Get("skip_get_ec2_platforms").(bool),
+		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
+		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
+		SkipMetadataApiCheck:           d.Get("skip_metadata_api_check").(bool),
+		S3ForcePathStyle:               d.Get("s3_force_path_style").(bool),
+		terraformVersion:               terraformVersion,
+	}
+
+	if v, ok := d.GetOk("max_backoff_seconds"); ok {
+		config.MaxBackoff = time.Duration(v.(int)) * time.Second
+	}
+
+	if v, ok := d.GetOk("rate_limit"); ok && v.(*schema.Set).Len() > 0 {
+		config.RateLimits = make(map[string]RateLimit)
+
+		for _, tfMapRaw := range v.(*schema.Set).List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			config.RateLimits[tfMap["service"].(string)] = RateLimit{
+				Burst:             tfMap["burst"].(int),
+				RequestsPerSecond: tfMap["requests_per_second"].(float64),
+			}
+		}
 	}
 
 	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
@@ -1375,6 +1549,48 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
 	}
 
+	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		m := l[0].(map[string]interface{})
+
+		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
+			config.AssumeRoleWithWebIdentityDurationSeconds = v
+		}
+
+		if v, ok := m["policy"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityPolicy = v
+		}
+
+		if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
+			for _, policyARNRaw := range policyARNSet.List() {
+				policyARN, ok := policyARNRaw.(string)
+
+				if !ok {
+					continue
+				}
+
+				config.AssumeRoleWithWebIdentityPolicyARNs = append(config.AssumeRoleWithWebIdentityPolicyARNs, policyARN)
+			}
+		}
+
+		if v, ok := m["role_arn"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityRoleARN = v
+		}
+
+		if v, ok := m["session_name"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentitySessionName = v
+		}
+
+		if v, ok := m["web_identity_token"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityToken = v
+		}
+
+		if v, ok := m["web_identity_token_file"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityTokenFile = v
+		}
+
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q)", config.AssumeRoleWithWebIdentityRoleARN, config.AssumeRoleWithWebIdentitySessionName)
+	}
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
@@ -1402,6 +1618,10 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 // This is a global MutexKV for use within this plugin.
 var awsMutexKV = mutexkv.NewMutexKV()
 
+// This is a global registry of how security group rules are managed, used to
+// detect groups whose rules are managed both inline and by standalone rules.
+var awsSecurityGroupRuleRegistry = registry.NewSecurityGroupRules()
+
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -1457,6 +1677,57 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration_seconds": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Seconds to restrict the assume role session duration.",
+				},
+				"policy": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+				},
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"role_arn": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Amazon Resource Name of an IAM Role to assume prior to making API calls.",
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Identifier for the assumed role session.",
+				},
+				"web_identity_token": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					Description:   "OpenID Connect (OIDC) or OAuth 2.0 access token provided by the identity provider.",
+					ConflictsWith: []string{"assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+				"web_identity_token_file": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Description:   "Path to a file containing an OpenID Connect (OIDC) or OAuth 2.0 access token provided by the identity provider.",
+					ConflictsWith: []string{"assume_role_with_web_identity.0.web_identity_token"},
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -0,0 +1,68 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// rdsCustomFiltersSchema returns a *schema.Schema that represents a set of
+// custom filtering criteria for the RDS "Describe..." functions, such as
+// DescribeDBInstances and DescribeDBClusters. It mirrors the schema returned
+// by ec2CustomFiltersSchema:
+//
+// filter {
+//   name   = "engine"
+//   values = ["aurora-postgresql"]
+// }
+func rdsCustomFiltersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"values": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// buildRDSCustomFilterList takes the set value extracted from a schema
+// attribute conforming to the schema returned by rdsCustomFiltersSchema,
+// and transforms it into a []*rds.Filter ready to pass into the "Filters"
+// attribute of the RDS "Describe..." functions.
+func buildRDSCustomFilterList(filterSet *schema.Set) []*rds.Filter {
+	if filterSet == nil {
+		return []*rds.Filter{}
+	}
+
+	customFilters := filterSet.List()
+	filters := make([]*rds.Filter, len(customFilters))
+
+	for filterIdx, customFilterI := range customFilters {
+		customFilterMapI := customFilterI.(map[string]interface{})
+		name := customFilterMapI["name"].(string)
+		valuesI := customFilterMapI["values"].(*schema.Set).List()
+		values := make([]*string, len(valuesI))
+		for valueIdx, valueI := range valuesI {
+			values[valueIdx] = aws.String(valueI.(string))
+		}
+
+		filters[filterIdx] = &rds.Filter{
+			Name:   &name,
+			Values: values,
+		}
+	}
+
+	return filters
+}
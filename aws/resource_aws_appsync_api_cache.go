@@ -0,0 +1,225 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsAppsyncApiCache() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppsyncApiCacheCreate,
+		Read:   resourceAwsAppsyncApiCacheRead,
+		Update: resourceAwsAppsyncApiCacheUpdate,
+		Delete: resourceAwsAppsyncApiCacheDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_caching_behavior": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(appsync.ApiCachingBehavior_Values(), false),
+			},
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"at_rest_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"transit_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 3600),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(appsync.ApiCacheType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsAppsyncApiCacheCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiID := d.Get("api_id").(string)
+
+	input := &appsync.CreateApiCacheInput{
+		ApiCachingBehavior:       aws.String(d.Get("api_caching_behavior").(string)),
+		ApiId:                    aws.String(apiID),
+		AtRestEncryptionEnabled:  aws.Bool(d.Get("at_rest_encryption_enabled").(bool)),
+		TransitEncryptionEnabled: aws.Bool(d.Get("transit_encryption_enabled").(bool)),
+		Ttl:                      aws.Int64(int64(d.Get("ttl").(int))),
+		Type:                     aws.String(d.Get("type").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating AppSync API Cache: %s", input)
+	_, err := conn.CreateApiCache(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating AppSync API Cache (%s): %w", apiID, err)
+	}
+
+	d.SetId(apiID)
+
+	if err := waitForAppsyncApiCacheAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for AppSync API Cache (%s) to become available: %w", d.Id(), err)
+	}
+
+	return resourceAwsAppsyncApiCacheRead(d, meta)
+}
+
+func resourceAwsAppsyncApiCacheRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	resp, err := conn.GetApiCache(&appsync.GetApiCacheInput{
+		ApiId: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appsync.ErrCodeNotFoundException) {
+		log.Printf("[WARN] AppSync API Cache (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppSync API Cache (%s): %w", d.Id(), err)
+	}
+
+	if resp == nil || resp.ApiCache == nil {
+		return fmt.Errorf("error reading AppSync API Cache (%s): empty response", d.Id())
+	}
+
+	cache := resp.ApiCache
+
+	d.Set("api_caching_behavior", cache.ApiCachingBehavior)
+	d.Set("api_id", d.Id())
+	d.Set("at_rest_encryption_enabled", cache.AtRestEncryptionEnabled)
+	d.Set("transit_encryption_enabled", cache.TransitEncryptionEnabled)
+	d.Set("ttl", cache.Ttl)
+	d.Set("type", cache.Type)
+
+	return nil
+}
+
+func resourceAwsAppsyncApiCacheUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	input := &appsync.UpdateApiCacheInput{
+		ApiCachingBehavior: aws.String(d.Get("api_caching_behavior").(string)),
+		ApiId:              aws.String(d.Id()),
+		Ttl:                aws.Int64(int64(d.Get("ttl").(int))),
+		Type:               aws.String(d.Get("type").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating AppSync API Cache: %s", input)
+	_, err := conn.UpdateApiCache(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating AppSync API Cache (%s): %w", d.Id(), err)
+	}
+
+	if err := waitForAppsyncApiCacheAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for AppSync API Cache (%s) to become available: %w", d.Id(), err)
+	}
+
+	return resourceAwsAppsyncApiCacheRead(d, meta)
+}
+
+func resourceAwsAppsyncApiCacheDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	log.Printf("[DEBUG] Deleting AppSync API Cache: %s", d.Id())
+	_, err := conn.DeleteApiCache(&appsync.DeleteApiCacheInput{
+		ApiId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appsync.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppSync API Cache (%s): %w", d.Id(), err)
+	}
+
+	if err := waitForAppsyncApiCacheDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for AppSync API Cache (%s) to delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func refreshAppsyncApiCacheStatus(conn *appsync.AppSync, apiID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.GetApiCache(&appsync.GetApiCacheInput{
+			ApiId: aws.String(apiID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, appsync.ErrCodeNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if resp == nil || resp.ApiCache == nil {
+			return nil, "", nil
+		}
+
+		return resp.ApiCache, aws.StringValue(resp.ApiCache.Status), nil
+	}
+}
+
+func waitForAppsyncApiCacheAvailable(conn *appsync.AppSync, apiID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appsync.ApiCacheStatusCreating, appsync.ApiCacheStatusModifying},
+		Target:  []string{appsync.ApiCacheStatusAvailable},
+		Refresh: refreshAppsyncApiCacheStatus(conn, apiID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForAppsyncApiCacheDeletion(conn *appsync.AppSync, apiID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appsync.ApiCacheStatusAvailable, appsync.ApiCacheStatusDeleting},
+		Target:  []string{},
+		Refresh: refreshAppsyncApiCacheStatus(conn, apiID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
@@ -0,0 +1,141 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSAppsyncApiCache_basic(t *testing.T) {
+	var apiCache appsync.ApiCache
+	resourceName := "aws_appsync_api_cache.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appsync.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncApiCacheDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncApiCacheConfig(rName, appsync.ApiCacheTypeSmall, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncApiCacheExists(resourceName, &apiCache),
+					resource.TestCheckResourceAttr(resourceName, "api_caching_behavior", appsync.ApiCachingBehaviorFullRequestCaching),
+					resource.TestCheckResourceAttrPair(resourceName, "api_id", "aws_appsync_graphql_api.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "at_rest_encryption_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "transit_encryption_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "60"),
+					resource.TestCheckResourceAttr(resourceName, "type", appsync.ApiCacheTypeSmall),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppsyncApiCacheConfig(rName, appsync.ApiCacheTypeMedium, 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncApiCacheExists(resourceName, &apiCache),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "120"),
+					resource.TestCheckResourceAttr(resourceName, "type", appsync.ApiCacheTypeMedium),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncApiCache_disappears(t *testing.T) {
+	var apiCache appsync.ApiCache
+	resourceName := "aws_appsync_api_cache.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appsync.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncApiCacheDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncApiCacheConfig(rName, appsync.ApiCacheTypeSmall, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncApiCacheExists(resourceName, &apiCache),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppsyncApiCache(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAppsyncApiCacheDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appsync_api_cache" {
+			continue
+		}
+
+		_, err := conn.GetApiCache(&appsync.GetApiCacheInput{
+			ApiId: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, appsync.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppSync API Cache (%s) still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccCheckAwsAppsyncApiCacheExists(resourceName string, apiCache *appsync.ApiCache) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+
+		resp, err := conn.GetApiCache(&appsync.GetApiCacheInput{
+			ApiId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if resp.ApiCache == nil {
+			return fmt.Errorf("AppSync API Cache (%s) not found", rs.Primary.ID)
+		}
+
+		*apiCache = *resp.ApiCache
+
+		return nil
+	}
+}
+
+func testAccAppsyncApiCacheConfig(rName, cacheType string, ttl int) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = %[1]q
+}
+
+resource "aws_appsync_api_cache" "test" {
+  api_id               = aws_appsync_graphql_api.test.id
+  api_caching_behavior = "FULL_REQUEST_CACHING"
+  type                 = %[2]q
+  ttl                  = %[3]d
+}
+`, rName, cacheType, ttl)
+}
@@ -17,7 +17,7 @@ func resourceAwsCloudWatchEventBus() *schema.Resource {
 		Update: resourceAwsCloudWatchEventBusUpdate,
 		Delete: resourceAwsCloudWatchEventBusDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByARN("events", arnResourceIDFunc("event-bus", "/"), schema.ImportStatePassthrough),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -3,9 +3,11 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -30,7 +32,7 @@ func resourceAwsCloudWatchEventRule() *schema.Resource {
 		Update: resourceAwsCloudWatchEventRuleUpdate,
 		Delete: resourceAwsCloudWatchEventRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByARN("events", resourceAwsCloudWatchEventRuleIDFromARN, schema.ImportStatePassthrough),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -346,3 +348,19 @@ func validateEventPatternValue() schema.SchemaValidateFunc {
 		return
 	}
 }
+
+// resourceAwsCloudWatchEventRuleIDFromARN returns the rule ID from a rule ARN,
+// which contains the event bus name for rules on custom event buses.
+func resourceAwsCloudWatchEventRuleIDFromARN(v arn.ARN) (string, error) {
+	parts := strings.Split(strings.TrimPrefix(v.Resource, "rule/"), "/")
+
+	if !strings.HasPrefix(v.Resource, "rule/") || len(parts) > 2 {
+		return "", fmt.Errorf("expected resource of the form rule/[<event-bus-name>/]<rule-name>, got %q", v.Resource)
+	}
+
+	if len(parts) == 1 {
+		return tfevents.RuleCreateID(tfevents.DefaultEventBusName, parts[0]), nil
+	}
+
+	return tfevents.RuleCreateID(parts[0], parts[1]), nil
+}
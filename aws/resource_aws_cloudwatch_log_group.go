@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -20,7 +21,7 @@ func resourceAwsCloudWatchLogGroup() *schema.Resource {
 		Update: resourceAwsCloudWatchLogGroupUpdate,
 		Delete: resourceAwsCloudWatchLogGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByARN("logs", resourceAwsCloudWatchLogGroupIDFromARN, schema.ImportStatePassthrough),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -243,3 +244,15 @@ func resourceAwsCloudWatchLogGroupDelete(d *schema.ResourceData, meta interface{
 
 	return nil
 }
+
+// resourceAwsCloudWatchLogGroupIDFromARN returns the log group name from a log
+// group ARN, with or without the trailing ":*" returned by the API.
+func resourceAwsCloudWatchLogGroupIDFromARN(v arn.ARN) (string, error) {
+	name := strings.TrimSuffix(strings.TrimPrefix(v.Resource, "log-group:"), ":*")
+
+	if !strings.HasPrefix(v.Resource, "log-group:") || name == "" || strings.Contains(name, ":") {
+		return "", fmt.Errorf("expected resource of the form log-group:<name>, got %q", v.Resource)
+	}
+
+	return name, nil
+}
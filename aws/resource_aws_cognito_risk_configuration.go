@@ -0,0 +1,527 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsCognitoRiskConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoRiskConfigurationPut,
+		Read:   resourceAwsCognitoRiskConfigurationRead,
+		Update: resourceAwsCognitoRiskConfigurationPut,
+		Delete: resourceAwsCognitoRiskConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_takeover_risk_configuration": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: cognitoRiskConfigurationKeys,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"high_action":   cognitoAccountTakeoverActionSchema(),
+									"low_action":    cognitoAccountTakeoverActionSchema(),
+									"medium_action": cognitoAccountTakeoverActionSchema(),
+								},
+							},
+						},
+						"notify_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"block_email": cognitoNotifyEmailSchema(),
+									"from": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"mfa_email":       cognitoNotifyEmailSchema(),
+									"no_action_email": cognitoNotifyEmailSchema(),
+									"reply_to": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"source_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"compromised_credentials_risk_configuration": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: cognitoRiskConfigurationKeys,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"event_action": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(cognitoidentityprovider.CompromisedCredentialsEventActionType_Values(), false),
+									},
+								},
+							},
+						},
+						"event_filter": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(cognitoidentityprovider.EventFilterType_Values(), false),
+							},
+						},
+					},
+				},
+			},
+			"risk_exception_configuration": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: cognitoRiskConfigurationKeys,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"blocked_ip_range_list": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 200,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateCIDRNetworkAddress,
+							},
+						},
+						"skipped_ip_range_list": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 200,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateCIDRNetworkAddress,
+							},
+						},
+					},
+				},
+			},
+			"user_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoUserPoolId,
+			},
+		},
+	}
+}
+
+var cognitoRiskConfigurationKeys = []string{
+	"account_takeover_risk_configuration",
+	"compromised_credentials_risk_configuration",
+	"risk_exception_configuration",
+}
+
+func cognitoAccountTakeoverActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"event_action": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(cognitoidentityprovider.AccountTakeoverEventActionType_Values(), false),
+				},
+				"notify": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func cognitoNotifyEmailSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"html_body": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(6, 20000),
+				},
+				"subject": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 140),
+				},
+				"text_body": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(6, 20000),
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoRiskConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolID := d.Get("user_pool_id").(string)
+	id := userPoolID
+
+	input := &cognitoidentityprovider.SetRiskConfigurationInput{
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	if v, ok := d.GetOk("client_id"); ok {
+		input.ClientId = aws.String(v.(string))
+		id = fmt.Sprintf("%s,%s", userPoolID, v.(string))
+	}
+
+	if v, ok := d.GetOk("account_takeover_risk_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AccountTakeoverRiskConfiguration = expandCognitoAccountTakeoverRiskConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("compromised_credentials_risk_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CompromisedCredentialsRiskConfiguration = expandCognitoCompromisedCredentialsRiskConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("risk_exception_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RiskExceptionConfiguration = expandCognitoRiskExceptionConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Setting Cognito Risk Configuration: %s", id)
+	_, err := conn.SetRiskConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error setting Cognito Risk Configuration (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsCognitoRiskConfigurationRead(d, meta)
+}
+
+func resourceAwsCognitoRiskConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolID, clientID := decodeCognitoRiskConfigurationID(d.Id())
+
+	input := &cognitoidentityprovider.DescribeRiskConfigurationInput{
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	if clientID != "" {
+		input.ClientId = aws.String(clientID)
+	}
+
+	output, err := conn.DescribeRiskConfiguration(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Cognito Risk Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Cognito Risk Configuration (%s): %w", d.Id(), err)
+	}
+
+	riskConfig := output.RiskConfiguration
+
+	if riskConfig == nil {
+		return fmt.Errorf("error reading Cognito Risk Configuration (%s): empty output", d.Id())
+	}
+
+	d.Set("client_id", clientID)
+	d.Set("user_pool_id", userPoolID)
+
+	if err := d.Set("account_takeover_risk_configuration", flattenCognitoAccountTakeoverRiskConfiguration(riskConfig.AccountTakeoverRiskConfiguration)); err != nil {
+		return fmt.Errorf("error setting account_takeover_risk_configuration: %w", err)
+	}
+
+	if err := d.Set("compromised_credentials_risk_configuration", flattenCognitoCompromisedCredentialsRiskConfiguration(riskConfig.CompromisedCredentialsRiskConfiguration)); err != nil {
+		return fmt.Errorf("error setting compromised_credentials_risk_configuration: %w", err)
+	}
+
+	if err := d.Set("risk_exception_configuration", flattenCognitoRiskExceptionConfiguration(riskConfig.RiskExceptionConfiguration)); err != nil {
+		return fmt.Errorf("error setting risk_exception_configuration: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoRiskConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolID, clientID := decodeCognitoRiskConfigurationID(d.Id())
+
+	// Setting no configuration blocks clears the risk configuration.
+	input := &cognitoidentityprovider.SetRiskConfigurationInput{
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	if clientID != "" {
+		input.ClientId = aws.String(clientID)
+	}
+
+	log.Printf("[DEBUG] Removing Cognito Risk Configuration: %s", d.Id())
+	_, err := conn.SetRiskConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error removing Cognito Risk Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeCognitoRiskConfigurationID(id string) (string, string) {
+	idParts := strings.SplitN(id, ",", 2)
+	if len(idParts) == 2 {
+		return idParts[0], idParts[1]
+	}
+	return idParts[0], ""
+}
+
+func expandCognitoAccountTakeoverRiskConfiguration(tfMap map[string]interface{}) *cognitoidentityprovider.AccountTakeoverRiskConfigurationType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cognitoidentityprovider.AccountTakeoverRiskConfigurationType{}
+
+	if v, ok := tfMap["actions"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		actions := v[0].(map[string]interface{})
+
+		apiObject.Actions = &cognitoidentityprovider.AccountTakeoverActionsType{
+			HighAction:   expandCognitoAccountTakeoverAction(actions["high_action"].([]interface{})),
+			LowAction:    expandCognitoAccountTakeoverAction(actions["low_action"].([]interface{})),
+			MediumAction: expandCognitoAccountTakeoverAction(actions["medium_action"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["notify_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		notify := v[0].(map[string]interface{})
+
+		notifyConfig := &cognitoidentityprovider.NotifyConfigurationType{
+			BlockEmail:    expandCognitoNotifyEmail(notify["block_email"].([]interface{})),
+			MfaEmail:      expandCognitoNotifyEmail(notify["mfa_email"].([]interface{})),
+			NoActionEmail: expandCognitoNotifyEmail(notify["no_action_email"].([]interface{})),
+			SourceArn:     aws.String(notify["source_arn"].(string)),
+		}
+
+		if v, ok := notify["from"].(string); ok && v != "" {
+			notifyConfig.From = aws.String(v)
+		}
+
+		if v, ok := notify["reply_to"].(string); ok && v != "" {
+			notifyConfig.ReplyTo = aws.String(v)
+		}
+
+		apiObject.NotifyConfiguration = notifyConfig
+	}
+
+	return apiObject
+}
+
+func expandCognitoAccountTakeoverAction(tfList []interface{}) *cognitoidentityprovider.AccountTakeoverActionType {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &cognitoidentityprovider.AccountTakeoverActionType{
+		EventAction: aws.String(tfMap["event_action"].(string)),
+		Notify:      aws.Bool(tfMap["notify"].(bool)),
+	}
+}
+
+func expandCognitoNotifyEmail(tfList []interface{}) *cognitoidentityprovider.NotifyEmailType {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &cognitoidentityprovider.NotifyEmailType{
+		HtmlBody: aws.String(tfMap["html_body"].(string)),
+		Subject:  aws.String(tfMap["subject"].(string)),
+		TextBody: aws.String(tfMap["text_body"].(string)),
+	}
+}
+
+func expandCognitoCompromisedCredentialsRiskConfiguration(tfMap map[string]interface{}) *cognitoidentityprovider.CompromisedCredentialsRiskConfigurationType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cognitoidentityprovider.CompromisedCredentialsRiskConfigurationType{}
+
+	if v, ok := tfMap["actions"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		actions := v[0].(map[string]interface{})
+
+		apiObject.Actions = &cognitoidentityprovider.CompromisedCredentialsActionsType{
+			EventAction: aws.String(actions["event_action"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["event_filter"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EventFilter = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandCognitoRiskExceptionConfiguration(tfMap map[string]interface{}) *cognitoidentityprovider.RiskExceptionConfigurationType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cognitoidentityprovider.RiskExceptionConfigurationType{}
+
+	if v, ok := tfMap["blocked_ip_range_list"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.BlockedIPRangeList = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["skipped_ip_range_list"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SkippedIPRangeList = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenCognitoAccountTakeoverRiskConfiguration(apiObject *cognitoidentityprovider.AccountTakeoverRiskConfigurationType) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if actions := apiObject.Actions; actions != nil {
+		tfMap["actions"] = []interface{}{
+			map[string]interface{}{
+				"high_action":   flattenCognitoAccountTakeoverAction(actions.HighAction),
+				"low_action":    flattenCognitoAccountTakeoverAction(actions.LowAction),
+				"medium_action": flattenCognitoAccountTakeoverAction(actions.MediumAction),
+			},
+		}
+	}
+
+	if notify := apiObject.NotifyConfiguration; notify != nil {
+		tfMap["notify_configuration"] = []interface{}{
+			map[string]interface{}{
+				"block_email":     flattenCognitoNotifyEmail(notify.BlockEmail),
+				"from":            aws.StringValue(notify.From),
+				"mfa_email":       flattenCognitoNotifyEmail(notify.MfaEmail),
+				"no_action_email": flattenCognitoNotifyEmail(notify.NoActionEmail),
+				"reply_to":        aws.StringValue(notify.ReplyTo),
+				"source_arn":      aws.StringValue(notify.SourceArn),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenCognitoAccountTakeoverAction(apiObject *cognitoidentityprovider.AccountTakeoverActionType) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"event_action": aws.StringValue(apiObject.EventAction),
+			"notify":       aws.BoolValue(apiObject.Notify),
+		},
+	}
+}
+
+func flattenCognitoNotifyEmail(apiObject *cognitoidentityprovider.NotifyEmailType) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"html_body": aws.StringValue(apiObject.HtmlBody),
+			"subject":   aws.StringValue(apiObject.Subject),
+			"text_body": aws.StringValue(apiObject.TextBody),
+		},
+	}
+}
+
+func flattenCognitoCompromisedCredentialsRiskConfiguration(apiObject *cognitoidentityprovider.CompromisedCredentialsRiskConfigurationType) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"event_filter": flattenStringSet(apiObject.EventFilter),
+	}
+
+	if actions := apiObject.Actions; actions != nil {
+		tfMap["actions"] = []interface{}{
+			map[string]interface{}{
+				"event_action": aws.StringValue(actions.EventAction),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenCognitoRiskExceptionConfiguration(apiObject *cognitoidentityprovider.RiskExceptionConfigurationType) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"blocked_ip_range_list": flattenStringSet(apiObject.BlockedIPRangeList),
+			"skipped_ip_range_list": flattenStringSet(apiObject.SkippedIPRangeList),
+		},
+	}
+}
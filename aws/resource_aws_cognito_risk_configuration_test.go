@@ -0,0 +1,288 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSCognitoRiskConfiguration_exception(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_risk_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoRiskConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoRiskConfigurationConfigException(rName, "10.10.10.10/32"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoRiskConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "user_pool_id", "aws_cognito_user_pool.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "risk_exception_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "risk_exception_configuration.0.blocked_ip_range_list.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "risk_exception_configuration.0.blocked_ip_range_list.*", "10.10.10.10/32"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCognitoRiskConfigurationConfigException(rName, "10.10.10.11/32"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoRiskConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "risk_exception_configuration.0.blocked_ip_range_list.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "risk_exception_configuration.0.blocked_ip_range_list.*", "10.10.10.11/32"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoRiskConfiguration_compromised(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_risk_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoRiskConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoRiskConfigurationConfigCompromised(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoRiskConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "client_id", "aws_cognito_user_pool_client.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "compromised_credentials_risk_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "compromised_credentials_risk_configuration.0.actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "compromised_credentials_risk_configuration.0.actions.0.event_action", cognitoidentityprovider.CompromisedCredentialsEventActionTypeBlock),
+					resource.TestCheckResourceAttr(resourceName, "compromised_credentials_risk_configuration.0.event_filter.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "compromised_credentials_risk_configuration.0.event_filter.*", cognitoidentityprovider.EventFilterTypeSignIn),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoRiskConfiguration_accountTakeover(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_risk_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoRiskConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoRiskConfigurationConfigAccountTakeover(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoRiskConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.0.actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.0.actions.0.high_action.0.event_action", cognitoidentityprovider.AccountTakeoverEventActionTypeBlock),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.0.actions.0.high_action.0.notify", "false"),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.0.actions.0.medium_action.0.event_action", cognitoidentityprovider.AccountTakeoverEventActionTypeMfaIfConfigured),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.0.actions.0.low_action.0.event_action", cognitoidentityprovider.AccountTakeoverEventActionTypeNoAction),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoRiskConfiguration_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_risk_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoRiskConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoRiskConfigurationConfigException(rName, "10.10.10.10/32"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoRiskConfigurationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCognitoRiskConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCognitoRiskConfigurationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cognito Risk Configuration ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+		output, err := testAccAWSCognitoRiskConfigurationDescribe(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if !testAccAWSCognitoRiskConfigurationIsSet(output) {
+			return fmt.Errorf("Cognito Risk Configuration %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCognitoRiskConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_risk_configuration" {
+			continue
+		}
+
+		output, err := testAccAWSCognitoRiskConfigurationDescribe(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if testAccAWSCognitoRiskConfigurationIsSet(output) {
+			return fmt.Errorf("Cognito Risk Configuration %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCognitoRiskConfigurationDescribe(conn *cognitoidentityprovider.CognitoIdentityProvider, id string) (*cognitoidentityprovider.RiskConfigurationType, error) {
+	userPoolID, clientID := decodeCognitoRiskConfigurationID(id)
+
+	input := &cognitoidentityprovider.DescribeRiskConfigurationInput{
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	if clientID != "" {
+		input.ClientId = aws.String(clientID)
+	}
+
+	output, err := conn.DescribeRiskConfiguration(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.RiskConfiguration, nil
+}
+
+func testAccAWSCognitoRiskConfigurationIsSet(riskConfig *cognitoidentityprovider.RiskConfigurationType) bool {
+	return riskConfig != nil && (riskConfig.AccountTakeoverRiskConfiguration != nil ||
+		riskConfig.CompromisedCredentialsRiskConfiguration != nil ||
+		riskConfig.RiskExceptionConfiguration != nil)
+}
+
+func testAccAWSCognitoRiskConfigurationConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+
+  user_pool_add_ons {
+    advanced_security_mode = "ENFORCED"
+  }
+}
+`, rName)
+}
+
+func testAccAWSCognitoRiskConfigurationConfigException(rName, blockedIPRange string) string {
+	return composeConfig(
+		testAccAWSCognitoRiskConfigurationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cognito_risk_configuration" "test" {
+  user_pool_id = aws_cognito_user_pool.test.id
+
+  risk_exception_configuration {
+    blocked_ip_range_list = [%[1]q]
+  }
+}
+`, blockedIPRange))
+}
+
+func testAccAWSCognitoRiskConfigurationConfigCompromised(rName string) string {
+	return composeConfig(
+		testAccAWSCognitoRiskConfigurationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cognito_user_pool_client" "test" {
+  name         = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+
+resource "aws_cognito_risk_configuration" "test" {
+  user_pool_id = aws_cognito_user_pool.test.id
+  client_id    = aws_cognito_user_pool_client.test.id
+
+  compromised_credentials_risk_configuration {
+    event_filter = ["SIGN_IN"]
+
+    actions {
+      event_action = "BLOCK"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAWSCognitoRiskConfigurationConfigAccountTakeover(rName string) string {
+	return composeConfig(
+		testAccAWSCognitoRiskConfigurationConfigBase(rName),
+		`
+resource "aws_cognito_risk_configuration" "test" {
+  user_pool_id = aws_cognito_user_pool.test.id
+
+  account_takeover_risk_configuration {
+    actions {
+      high_action {
+        event_action = "BLOCK"
+        notify       = false
+      }
+
+      medium_action {
+        event_action = "MFA_IF_CONFIGURED"
+        notify       = false
+      }
+
+      low_action {
+        event_action = "NO_ACTION"
+        notify       = false
+      }
+    }
+  }
+}
+`)
+}
@@ -0,0 +1,428 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsCognitoUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoUserCreate,
+		Read:   resourceAwsCognitoUserRead,
+		Update: resourceAwsCognitoUserUpdate,
+		Delete: resourceAwsCognitoUserDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCognitoUserImport,
+		},
+
+		// https://docs.aws.amazon.com/cognito-user-identity-pools/latest/APIReference/API_AdminCreateUser.html
+		Schema: map[string]*schema.Schema{
+			"attributes": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"client_metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"desired_delivery_mediums": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(cognitoidentityprovider.DeliveryMediumType_Values(), false),
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"force_alias_creation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"message_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(cognitoidentityprovider.MessageActionType_Values(), false),
+			},
+			"mfa_setting_list": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringLenBetween(6, 256),
+				ConflictsWith: []string{"temporary_password"},
+			},
+			"preferred_mfa_setting": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sub": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"temporary_password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringLenBetween(6, 256),
+				ConflictsWith: []string{"password"},
+			},
+			"user_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoUserPoolId,
+			},
+			"username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"validation_data": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoUserCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolID := d.Get("user_pool_id").(string)
+	username := d.Get("username").(string)
+
+	params := &cognitoidentityprovider.AdminCreateUserInput{
+		Username:   aws.String(username),
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	if v, ok := d.GetOk("attributes"); ok {
+		params.UserAttributes = expandCognitoUserAttributes(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("client_metadata"); ok {
+		params.ClientMetadata = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("desired_delivery_mediums"); ok && v.(*schema.Set).Len() > 0 {
+		params.DesiredDeliveryMediums = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("force_alias_creation"); ok {
+		params.ForceAliasCreation = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("message_action"); ok {
+		params.MessageAction = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("temporary_password"); ok {
+		params.TemporaryPassword = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("validation_data"); ok {
+		params.ValidationData = expandCognitoUserAttributes(v.(map[string]interface{}))
+	}
+
+	log.Print("[DEBUG] Creating Cognito User")
+
+	_, err := conn.AdminCreateUser(params)
+
+	if err != nil {
+		return fmt.Errorf("error creating Cognito User (%s): %w", username, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", userPoolID, username))
+
+	if v, ok := d.GetOk("password"); ok {
+		if err := setCognitoUserPassword(conn, userPoolID, username, v.(string), true); err != nil {
+			return err
+		}
+	}
+
+	if !d.Get("enabled").(bool) {
+		if err := setCognitoUserEnabled(conn, userPoolID, username, false); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsCognitoUserRead(d, meta)
+}
+
+func resourceAwsCognitoUserRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolID, username, err := decodeCognitoUserID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.AdminGetUser(&cognitoidentityprovider.AdminGetUserInput{
+		Username:   aws.String(username),
+		UserPoolId: aws.String(userPoolID),
+	})
+
+	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeUserNotFoundException) || tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException)) {
+		log.Printf("[WARN] Cognito User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Cognito User (%s): %w", d.Id(), err)
+	}
+
+	attributes, sub := flattenCognitoUserAttributes(resp.UserAttributes)
+
+	if err := d.Set("attributes", attributes); err != nil {
+		return fmt.Errorf("error setting attributes: %w", err)
+	}
+
+	if resp.UserCreateDate != nil {
+		d.Set("creation_date", aws.TimeValue(resp.UserCreateDate).Format(time.RFC3339))
+	}
+	d.Set("enabled", resp.Enabled)
+	if resp.UserLastModifiedDate != nil {
+		d.Set("last_modified_date", aws.TimeValue(resp.UserLastModifiedDate).Format(time.RFC3339))
+	}
+
+	if err := d.Set("mfa_setting_list", aws.StringValueSlice(resp.UserMFASettingList)); err != nil {
+		return fmt.Errorf("error setting mfa_setting_list: %w", err)
+	}
+
+	d.Set("preferred_mfa_setting", resp.PreferredMfaSetting)
+	d.Set("status", resp.UserStatus)
+	d.Set("sub", sub)
+	d.Set("user_pool_id", userPoolID)
+	d.Set("username", username)
+
+	return nil
+}
+
+func resourceAwsCognitoUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolID := d.Get("user_pool_id").(string)
+	username := d.Get("username").(string)
+
+	if d.HasChange("attributes") {
+		o, n := d.GetChange("attributes")
+		om := o.(map[string]interface{})
+		nm := n.(map[string]interface{})
+
+		upsert := make(map[string]interface{})
+		for k, v := range nm {
+			if old, ok := om[k]; !ok || old != v {
+				upsert[k] = v
+			}
+		}
+
+		var remove []*string
+		for k := range om {
+			if _, ok := nm[k]; !ok {
+				remove = append(remove, aws.String(k))
+			}
+		}
+
+		if len(upsert) > 0 {
+			input := &cognitoidentityprovider.AdminUpdateUserAttributesInput{
+				Username:       aws.String(username),
+				UserPoolId:     aws.String(userPoolID),
+				UserAttributes: expandCognitoUserAttributes(upsert),
+			}
+
+			if v, ok := d.GetOk("client_metadata"); ok {
+				input.ClientMetadata = stringMapToPointers(v.(map[string]interface{}))
+			}
+
+			if _, err := conn.AdminUpdateUserAttributes(input); err != nil {
+				return fmt.Errorf("error updating Cognito User (%s) attributes: %w", d.Id(), err)
+			}
+		}
+
+		if len(remove) > 0 {
+			input := &cognitoidentityprovider.AdminDeleteUserAttributesInput{
+				Username:           aws.String(username),
+				UserPoolId:         aws.String(userPoolID),
+				UserAttributeNames: remove,
+			}
+
+			if _, err := conn.AdminDeleteUserAttributes(input); err != nil {
+				return fmt.Errorf("error deleting Cognito User (%s) attributes: %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("enabled") {
+		if err := setCognitoUserEnabled(conn, userPoolID, username, d.Get("enabled").(bool)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("temporary_password") {
+		if v, ok := d.GetOk("temporary_password"); ok {
+			if err := setCognitoUserPassword(conn, userPoolID, username, v.(string), false); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("password") {
+		if v, ok := d.GetOk("password"); ok {
+			if err := setCognitoUserPassword(conn, userPoolID, username, v.(string), true); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceAwsCognitoUserRead(d, meta)
+}
+
+func resourceAwsCognitoUserDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	log.Print("[DEBUG] Deleting Cognito User")
+
+	_, err := conn.AdminDeleteUser(&cognitoidentityprovider.AdminDeleteUserInput{
+		Username:   aws.String(d.Get("username").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeUserNotFoundException) || tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Cognito User (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoUserImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	userPoolID, username, err := decodeCognitoUserID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("user_pool_id", userPoolID)
+	d.Set("username", username)
+	return []*schema.ResourceData{d}, nil
+}
+
+func decodeCognitoUserID(id string) (string, string, error) {
+	idParts := strings.SplitN(id, "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("expected ID in format of USERPOOLID/USERNAME, received: %s", id)
+	}
+	return idParts[0], idParts[1], nil
+}
+
+func setCognitoUserEnabled(conn *cognitoidentityprovider.CognitoIdentityProvider, userPoolID, username string, enabled bool) error {
+	var err error
+
+	if enabled {
+		_, err = conn.AdminEnableUser(&cognitoidentityprovider.AdminEnableUserInput{
+			Username:   aws.String(username),
+			UserPoolId: aws.String(userPoolID),
+		})
+	} else {
+		_, err = conn.AdminDisableUser(&cognitoidentityprovider.AdminDisableUserInput{
+			Username:   aws.String(username),
+			UserPoolId: aws.String(userPoolID),
+		})
+	}
+
+	if err != nil {
+		return fmt.Errorf("error setting Cognito User (%s/%s) enabled to %t: %w", userPoolID, username, enabled, err)
+	}
+
+	return nil
+}
+
+func setCognitoUserPassword(conn *cognitoidentityprovider.CognitoIdentityProvider, userPoolID, username, password string, permanent bool) error {
+	_, err := conn.AdminSetUserPassword(&cognitoidentityprovider.AdminSetUserPasswordInput{
+		Password:   aws.String(password),
+		Permanent:  aws.Bool(permanent),
+		Username:   aws.String(username),
+		UserPoolId: aws.String(userPoolID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error setting Cognito User (%s/%s) password: %w", userPoolID, username, err)
+	}
+
+	return nil
+}
+
+func expandCognitoUserAttributes(m map[string]interface{}) []*cognitoidentityprovider.AttributeType {
+	attributes := make([]*cognitoidentityprovider.AttributeType, 0, len(m))
+
+	for k, v := range m {
+		attributes = append(attributes, &cognitoidentityprovider.AttributeType{
+			Name:  aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return attributes
+}
+
+// flattenCognitoUserAttributes returns the user attributes without the
+// immutable "sub" attribute, which is returned separately.
+func flattenCognitoUserAttributes(attributes []*cognitoidentityprovider.AttributeType) (map[string]string, string) {
+	m := make(map[string]string, len(attributes))
+	var sub string
+
+	for _, attribute := range attributes {
+		if attribute == nil {
+			continue
+		}
+
+		name := aws.StringValue(attribute.Name)
+
+		if name == "sub" {
+			sub = aws.StringValue(attribute.Value)
+			continue
+		}
+
+		m[name] = aws.StringValue(attribute.Value)
+	}
+
+	return m, sub
+}
@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsCognitoUserInGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoUserInGroupCreate,
+		Read:   resourceAwsCognitoUserInGroupRead,
+		Delete: resourceAwsCognitoUserInGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCognitoUserInGroupImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoUserGroupName,
+			},
+			"user_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoUserPoolId,
+			},
+			"username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoUserInGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	groupName := d.Get("group_name").(string)
+	userPoolID := d.Get("user_pool_id").(string)
+	username := d.Get("username").(string)
+
+	input := &cognitoidentityprovider.AdminAddUserToGroupInput{
+		GroupName:  aws.String(groupName),
+		Username:   aws.String(username),
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	log.Printf("[DEBUG] Adding Cognito User (%s) to Group (%s)", username, groupName)
+	_, err := conn.AdminAddUserToGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error adding Cognito User (%s) to Group (%s): %w", username, groupName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", userPoolID, groupName, username))
+
+	return resourceAwsCognitoUserInGroupRead(d, meta)
+}
+
+func resourceAwsCognitoUserInGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	groupName := d.Get("group_name").(string)
+	userPoolID := d.Get("user_pool_id").(string)
+	username := d.Get("username").(string)
+
+	input := &cognitoidentityprovider.AdminListGroupsForUserInput{
+		Username:   aws.String(username),
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	found := false
+
+	err := conn.AdminListGroupsForUserPages(input, func(page *cognitoidentityprovider.AdminListGroupsForUserOutput, lastPage bool) bool {
+		for _, group := range page.Groups {
+			if aws.StringValue(group.GroupName) == groupName {
+				found = true
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeUserNotFoundException) || tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException)) {
+		log.Printf("[WARN] Cognito User In Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing groups for Cognito User (%s): %w", username, err)
+	}
+
+	if !found {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Cognito User In Group (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Cognito User In Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoUserInGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	input := &cognitoidentityprovider.AdminRemoveUserFromGroupInput{
+		GroupName:  aws.String(d.Get("group_name").(string)),
+		Username:   aws.String(d.Get("username").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Removing Cognito User In Group: %s", d.Id())
+	_, err := conn.AdminRemoveUserFromGroup(input)
+
+	if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeUserNotFoundException) || tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error removing Cognito User In Group (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoUserInGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 3)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return nil, fmt.Errorf("expected ID in format of USERPOOLID/GROUPNAME/USERNAME, received: %s", d.Id())
+	}
+
+	d.Set("user_pool_id", idParts[0])
+	d.Set("group_name", idParts[1])
+	d.Set("username", idParts[2])
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,161 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSCognitoUserInGroup_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user_in_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserInGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserInGroupConfigBasic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserInGroupExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "group_name", "aws_cognito_user_group.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_pool_id", "aws_cognito_user_pool.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "username", "aws_cognito_user.test", "username"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserInGroup_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user_in_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserInGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserInGroupConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserInGroupExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCognitoUserInGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCognitoUserInGroupExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cognito User In Group ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+		found, err := testAccAWSCognitoUserInGroupFind(conn, rs)
+
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("Cognito User In Group %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCognitoUserInGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_user_in_group" {
+			continue
+		}
+
+		found, err := testAccAWSCognitoUserInGroupFind(conn, rs)
+
+		if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeUserNotFoundException) || tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if found {
+			return fmt.Errorf("Cognito User In Group %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCognitoUserInGroupFind(conn *cognitoidentityprovider.CognitoIdentityProvider, rs *terraform.ResourceState) (bool, error) {
+	input := &cognitoidentityprovider.AdminListGroupsForUserInput{
+		Username:   aws.String(rs.Primary.Attributes["username"]),
+		UserPoolId: aws.String(rs.Primary.Attributes["user_pool_id"]),
+	}
+
+	found := false
+
+	err := conn.AdminListGroupsForUserPages(input, func(page *cognitoidentityprovider.AdminListGroupsForUserOutput, lastPage bool) bool {
+		for _, group := range page.Groups {
+			if aws.StringValue(group.GroupName) == rs.Primary.Attributes["group_name"] {
+				found = true
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return found, err
+}
+
+func testAccAWSCognitoUserInGroupConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+resource "aws_cognito_user_group" "test" {
+  name         = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+
+resource "aws_cognito_user" "test" {
+  user_pool_id   = aws_cognito_user_pool.test.id
+  username       = %[1]q
+  message_action = "SUPPRESS"
+}
+
+resource "aws_cognito_user_in_group" "test" {
+  user_pool_id = aws_cognito_user_pool.test.id
+  group_name   = aws_cognito_user_group.test.name
+  username     = aws_cognito_user.test.username
+}
+`, rName)
+}
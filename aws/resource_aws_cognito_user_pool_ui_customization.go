@@ -0,0 +1,201 @@
+package aws
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// cognitoUserPoolUICustomizationAllClients is the client ID that applies the
+// customization to every app client in the user pool.
+const cognitoUserPoolUICustomizationAllClients = "ALL"
+
+func resourceAwsCognitoUserPoolUICustomization() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoUserPoolUICustomizationPut,
+		Read:   resourceAwsCognitoUserPoolUICustomizationRead,
+		Update: resourceAwsCognitoUserPoolUICustomizationPut,
+		Delete: resourceAwsCognitoUserPoolUICustomizationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"client_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  cognitoUserPoolUICustomizationAllClients,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"css": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"css", "image_file"},
+			},
+			"css_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"image_file", "css"},
+				ValidateFunc: validation.StringIsBase64,
+			},
+			"image_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoUserPoolId,
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoUserPoolUICustomizationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	clientID := d.Get("client_id").(string)
+	userPoolID := d.Get("user_pool_id").(string)
+
+	input := &cognitoidentityprovider.SetUICustomizationInput{
+		ClientId:   aws.String(clientID),
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	if v, ok := d.GetOk("css"); ok {
+		input.CSS = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_file"); ok {
+		imageFile, err := base64.StdEncoding.DecodeString(v.(string))
+
+		if err != nil {
+			return fmt.Errorf("error decoding Cognito User Pool UI customization image_file: %w", err)
+		}
+
+		input.ImageFile = imageFile
+	}
+
+	log.Printf("[DEBUG] Setting Cognito User Pool UI customization for user pool (%s) and client (%s)", userPoolID, clientID)
+	_, err := conn.SetUICustomization(input)
+
+	if err != nil {
+		return fmt.Errorf("error setting Cognito User Pool UI customization (UserPoolId: %s, ClientId: %s): %w", userPoolID, clientID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", userPoolID, clientID))
+
+	return resourceAwsCognitoUserPoolUICustomizationRead(d, meta)
+}
+
+func resourceAwsCognitoUserPoolUICustomizationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolID, clientID, err := decodeCognitoUserPoolUICustomizationID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetUICustomization(&cognitoidentityprovider.GetUICustomizationInput{
+		ClientId:   aws.String(clientID),
+		UserPoolId: aws.String(userPoolID),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Cognito User Pool UI customization (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Cognito User Pool UI customization (%s): %w", d.Id(), err)
+	}
+
+	uiCustomization := output.UICustomization
+
+	// The API returns an empty customization rather than an error once it has
+	// been removed.
+	if uiCustomization == nil || (uiCustomization.CSS == nil && uiCustomization.ImageUrl == nil) {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Cognito User Pool UI customization (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Cognito User Pool UI customization (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("client_id", clientID)
+	if uiCustomization.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(uiCustomization.CreationDate).Format(time.RFC3339))
+	}
+	d.Set("css", uiCustomization.CSS)
+	d.Set("css_version", uiCustomization.CSSVersion)
+	d.Set("image_url", uiCustomization.ImageUrl)
+	if uiCustomization.LastModifiedDate != nil {
+		d.Set("last_modified_date", aws.TimeValue(uiCustomization.LastModifiedDate).Format(time.RFC3339))
+	}
+	d.Set("user_pool_id", userPoolID)
+
+	return nil
+}
+
+func resourceAwsCognitoUserPoolUICustomizationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolID, clientID, err := decodeCognitoUserPoolUICustomizationID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	// Setting neither CSS nor an image file removes the customization.
+	input := &cognitoidentityprovider.SetUICustomizationInput{
+		ClientId:   aws.String(clientID),
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	log.Printf("[DEBUG] Removing Cognito User Pool UI customization: %s", d.Id())
+	_, err = conn.SetUICustomization(input)
+
+	if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error removing Cognito User Pool UI customization (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeCognitoUserPoolUICustomizationID(id string) (string, string, error) {
+	idParts := strings.Split(id, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("expected ID in format of USERPOOLID,CLIENTID, received: %s", id)
+	}
+	return idParts[0], idParts[1], nil
+}
@@ -0,0 +1,196 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSCognitoUserPoolUICustomization_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user_pool_ui_customization.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolUICustomizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolUICustomizationConfigCSS(rName, ".label-customizable {font-weight: 400;}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolUICustomizationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "client_id", cognitoUserPoolUICustomizationAllClients),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "css", ".label-customizable {font-weight: 400;}"),
+					resource.TestCheckResourceAttrSet(resourceName, "css_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_date"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_pool_id", "aws_cognito_user_pool.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCognitoUserPoolUICustomizationConfigCSS(rName, ".label-customizable {font-weight: 100;}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolUICustomizationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "css", ".label-customizable {font-weight: 100;}"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPoolUICustomization_clientID(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user_pool_ui_customization.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolUICustomizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolUICustomizationConfigClientID(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolUICustomizationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "client_id", "aws_cognito_user_pool_client.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPoolUICustomization_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user_pool_ui_customization.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolUICustomizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolUICustomizationConfigCSS(rName, ".label-customizable {font-weight: 400;}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolUICustomizationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCognitoUserPoolUICustomization(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCognitoUserPoolUICustomizationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cognito User Pool UI customization ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+		output, err := conn.GetUICustomization(&cognitoidentityprovider.GetUICustomizationInput{
+			ClientId:   aws.String(rs.Primary.Attributes["client_id"]),
+			UserPoolId: aws.String(rs.Primary.Attributes["user_pool_id"]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output.UICustomization == nil || (output.UICustomization.CSS == nil && output.UICustomization.ImageUrl == nil) {
+			return fmt.Errorf("Cognito User Pool UI customization %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCognitoUserPoolUICustomizationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_user_pool_ui_customization" {
+			continue
+		}
+
+		output, err := conn.GetUICustomization(&cognitoidentityprovider.GetUICustomizationInput{
+			ClientId:   aws.String(rs.Primary.Attributes["client_id"]),
+			UserPoolId: aws.String(rs.Primary.Attributes["user_pool_id"]),
+		})
+
+		if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output.UICustomization != nil && (output.UICustomization.CSS != nil || output.UICustomization.ImageUrl != nil) {
+			return fmt.Errorf("Cognito User Pool UI customization %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCognitoUserPoolUICustomizationConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+resource "aws_cognito_user_pool_domain" "test" {
+  domain       = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+`, rName)
+}
+
+func testAccAWSCognitoUserPoolUICustomizationConfigCSS(rName, css string) string {
+	return composeConfig(
+		testAccAWSCognitoUserPoolUICustomizationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cognito_user_pool_ui_customization" "test" {
+  css          = %[1]q
+  user_pool_id = aws_cognito_user_pool_domain.test.user_pool_id
+}
+`, css))
+}
+
+func testAccAWSCognitoUserPoolUICustomizationConfigClientID(rName string) string {
+	return composeConfig(
+		testAccAWSCognitoUserPoolUICustomizationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cognito_user_pool_client" "test" {
+  name         = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+
+resource "aws_cognito_user_pool_ui_customization" "test" {
+  client_id    = aws_cognito_user_pool_client.test.id
+  css          = ".label-customizable {font-weight: 400;}"
+  user_pool_id = aws_cognito_user_pool_domain.test.user_pool_id
+}
+`, rName))
+}
@@ -0,0 +1,297 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSCognitoUser_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserConfigBasic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_date"),
+					resource.TestCheckResourceAttr(resourceName, "status", cognitoidentityprovider.UserStatusTypeForceChangePassword),
+					resource.TestCheckResourceAttrSet(resourceName, "sub"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_pool_id", "aws_cognito_user_pool.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "username", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"message_action",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUser_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCognitoUser(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUser_attributes(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserConfigAttributes(rName, "Jane", "Doe"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.given_name", "Jane"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.family_name", "Doe"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"message_action",
+				},
+			},
+			{
+				Config: testAccAWSCognitoUserConfigAttributesUpdated(rName, "Janet"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.given_name", "Janet"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUser_enabled(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserConfigEnabled(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoUserConfigEnabled(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUser_password(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserConfigPassword(rName, "Passw0rd!1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", cognitoidentityprovider.UserStatusTypeConfirmed),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"message_action",
+					"password",
+				},
+			},
+			{
+				Config: testAccAWSCognitoUserConfigPassword(rName, "Passw0rd!2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", cognitoidentityprovider.UserStatusTypeConfirmed),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCognitoUserExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cognito User ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+		_, err := conn.AdminGetUser(&cognitoidentityprovider.AdminGetUserInput{
+			Username:   aws.String(rs.Primary.Attributes["username"]),
+			UserPoolId: aws.String(rs.Primary.Attributes["user_pool_id"]),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSCognitoUserDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_user" {
+			continue
+		}
+
+		_, err := conn.AdminGetUser(&cognitoidentityprovider.AdminGetUserInput{
+			Username:   aws.String(rs.Primary.Attributes["username"]),
+			UserPoolId: aws.String(rs.Primary.Attributes["user_pool_id"]),
+		})
+
+		if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeUserNotFoundException) || tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Cognito User %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSCognitoUserConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSCognitoUserConfigBasic(rName string) string {
+	return composeConfig(
+		testAccAWSCognitoUserConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cognito_user" "test" {
+  user_pool_id   = aws_cognito_user_pool.test.id
+  username       = %[1]q
+  message_action = "SUPPRESS"
+}
+`, rName))
+}
+
+func testAccAWSCognitoUserConfigAttributes(rName, givenName, familyName string) string {
+	return composeConfig(
+		testAccAWSCognitoUserConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cognito_user" "test" {
+  user_pool_id   = aws_cognito_user_pool.test.id
+  username       = %[1]q
+  message_action = "SUPPRESS"
+
+  attributes = {
+    given_name  = %[2]q
+    family_name = %[3]q
+  }
+}
+`, rName, givenName, familyName))
+}
+
+func testAccAWSCognitoUserConfigAttributesUpdated(rName, givenName string) string {
+	return composeConfig(
+		testAccAWSCognitoUserConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cognito_user" "test" {
+  user_pool_id   = aws_cognito_user_pool.test.id
+  username       = %[1]q
+  message_action = "SUPPRESS"
+
+  attributes = {
+    given_name = %[2]q
+  }
+}
+`, rName, givenName))
+}
+
+func testAccAWSCognitoUserConfigEnabled(rName string, enabled bool) string {
+	return composeConfig(
+		testAccAWSCognitoUserConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cognito_user" "test" {
+  user_pool_id   = aws_cognito_user_pool.test.id
+  username       = %[1]q
+  message_action = "SUPPRESS"
+  enabled        = %[2]t
+}
+`, rName, enabled))
+}
+
+func testAccAWSCognitoUserConfigPassword(rName, password string) string {
+	return composeConfig(
+		testAccAWSCognitoUserConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cognito_user" "test" {
+  user_pool_id   = aws_cognito_user_pool.test.id
+  username       = %[1]q
+  message_action = "SUPPRESS"
+  password       = %[2]q
+}
+`, rName, password))
+}
@@ -0,0 +1,242 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsConfigConformancePack() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsConfigConformancePackPut,
+		Read:   resourceAwsConfigConformancePackRead,
+		Update: resourceAwsConfigConformancePackPut,
+		Delete: resourceAwsConfigConformancePackDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delivery_s3_bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 63),
+			},
+			"delivery_s3_key_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"input_parameter": configConformancePackInputParameterSchema(),
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][-a-zA-Z0-9]*$`), "must begin with a letter and contain only alphanumeric characters and hyphens"),
+				),
+			},
+			"template_body": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.All(validation.StringLenBetween(1, 51200), validateStringIsJsonOrYaml),
+				DiffSuppressFunc: suppressEquivalentJsonOrYamlDiffs,
+				ExactlyOneOf:     []string{"template_body", "template_s3_uri"},
+			},
+			"template_s3_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.All(validation.StringLenBetween(1, 1024), validation.StringMatch(regexp.MustCompile(`^s3://`), "must begin with s3://")),
+				ExactlyOneOf: []string{"template_s3_uri", "template_body"},
+			},
+		},
+	}
+}
+
+func configConformancePackInputParameterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 60,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"parameter_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"parameter_value": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(0, 4096),
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsConfigConformancePackPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	name := d.Get("name").(string)
+
+	input := &configservice.PutConformancePackInput{
+		ConformancePackName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("delivery_s3_bucket"); ok {
+		input.DeliveryS3Bucket = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("delivery_s3_key_prefix"); ok {
+		input.DeliveryS3KeyPrefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("input_parameter"); ok && v.(*schema.Set).Len() > 0 {
+		input.ConformancePackInputParameters = expandConfigConformancePackInputParameters(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("template_body"); ok {
+		input.TemplateBody = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("template_s3_uri"); ok {
+		input.TemplateS3Uri = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Putting Config Conformance Pack: %s", input)
+	_, err := conn.PutConformancePack(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting Config Conformance Pack (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+
+	if err := configWaitForConformancePackStateCreateComplete(conn, d.Id(), timeout); err != nil {
+		return fmt.Errorf("error waiting for Config Conformance Pack (%s) to be created: %w", d.Id(), err)
+	}
+
+	return resourceAwsConfigConformancePackRead(d, meta)
+}
+
+func resourceAwsConfigConformancePackRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	pack, err := configDescribeConformancePack(conn, d.Id())
+
+	if !d.IsNewResource() && isAWSErr(err, configservice.ErrCodeNoSuchConformancePackException, "") {
+		log.Printf("[WARN] Config Conformance Pack (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error describing Config Conformance Pack (%s): %w", d.Id(), err)
+	}
+
+	if pack == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error describing Config Conformance Pack (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] Config Conformance Pack (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", pack.ConformancePackArn)
+	d.Set("delivery_s3_bucket", pack.DeliveryS3Bucket)
+	d.Set("delivery_s3_key_prefix", pack.DeliveryS3KeyPrefix)
+	d.Set("name", pack.ConformancePackName)
+
+	if err := d.Set("input_parameter", flattenConfigConformancePackInputParameters(pack.ConformancePackInputParameters)); err != nil {
+		return fmt.Errorf("error setting input_parameter: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsConfigConformancePackDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	input := &configservice.DeleteConformancePackInput{
+		ConformancePackName: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Config Conformance Pack: %s", d.Id())
+	_, err := conn.DeleteConformancePack(input)
+
+	if isAWSErr(err, configservice.ErrCodeNoSuchConformancePackException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Config Conformance Pack (%s): %w", d.Id(), err)
+	}
+
+	if err := configWaitForConformancePackStateDeleteComplete(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Config Conformance Pack (%s) to be deleted: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandConfigConformancePackInputParameters(tfList []interface{}) []*configservice.ConformancePackInputParameter {
+	var apiObjects []*configservice.ConformancePackInputParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &configservice.ConformancePackInputParameter{
+			ParameterName:  aws.String(tfMap["parameter_name"].(string)),
+			ParameterValue: aws.String(tfMap["parameter_value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenConfigConformancePackInputParameters(apiObjects []*configservice.ConformancePackInputParameter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"parameter_name":  aws.StringValue(apiObject.ParameterName),
+			"parameter_value": aws.StringValue(apiObject.ParameterValue),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,271 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccConfigOrganizationConformancePack_basic(t *testing.T) {
+	var pack configservice.OrganizationConformancePack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_config_organization_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigOrganizationConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigOrganizationConformancePackConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigOrganizationConformancePackExists(resourceName, &pack),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "config", regexp.MustCompile(fmt.Sprintf("organization-conformance-pack/%s-.+", rName))),
+					resource.TestCheckResourceAttr(resourceName, "delivery_s3_bucket", ""),
+					resource.TestCheckResourceAttr(resourceName, "delivery_s3_key_prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "excluded_accounts.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "input_parameter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_body"},
+			},
+		},
+	})
+}
+
+func testAccConfigOrganizationConformancePack_disappears(t *testing.T) {
+	var pack configservice.OrganizationConformancePack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_config_organization_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigOrganizationConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigOrganizationConformancePackConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigOrganizationConformancePackExists(resourceName, &pack),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsConfigOrganizationConformancePack(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccConfigOrganizationConformancePack_ExcludedAccounts(t *testing.T) {
+	var pack configservice.OrganizationConformancePack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_config_organization_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigOrganizationConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigOrganizationConformancePackConfigExcludedAccounts(rName, `"111111111111"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigOrganizationConformancePackExists(resourceName, &pack),
+					resource.TestCheckResourceAttr(resourceName, "excluded_accounts.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_body"},
+			},
+			{
+				Config: testAccConfigOrganizationConformancePackConfigExcludedAccounts(rName, `"111111111111", "222222222222"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigOrganizationConformancePackExists(resourceName, &pack),
+					resource.TestCheckResourceAttr(resourceName, "excluded_accounts.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigOrganizationConformancePack_InputParameters(t *testing.T) {
+	var pack configservice.OrganizationConformancePack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_config_organization_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigOrganizationConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigOrganizationConformancePackConfigInputParameter(rName, "TestKey", "TestValue"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigOrganizationConformancePackExists(resourceName, &pack),
+					resource.TestCheckResourceAttr(resourceName, "input_parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "input_parameter.*", map[string]string{
+						"parameter_name":  "TestKey",
+						"parameter_value": "TestValue",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_body"},
+			},
+		},
+	})
+}
+
+func testAccCheckConfigOrganizationConformancePackExists(resourceName string, ocp *configservice.OrganizationConformancePack) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).configconn
+
+		pack, err := configDescribeOrganizationConformancePack(conn, rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error describing Config Organization Conformance Pack (%s): %w", rs.Primary.ID, err)
+		}
+
+		if pack == nil {
+			return fmt.Errorf("Config Organization Conformance Pack (%s) not found", rs.Primary.ID)
+		}
+
+		*ocp = *pack
+
+		return nil
+	}
+}
+
+func testAccCheckConfigOrganizationConformancePackDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).configconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_config_organization_conformance_pack" {
+			continue
+		}
+
+		pack, err := configDescribeOrganizationConformancePack(conn, rs.Primary.ID)
+
+		if isAWSErr(err, configservice.ErrCodeNoSuchOrganizationConformancePackException, "") {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error describing Config Organization Conformance Pack (%s): %w", rs.Primary.ID, err)
+		}
+
+		if pack != nil {
+			return fmt.Errorf("Config Organization Conformance Pack (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccConfigOrganizationConformancePackConfigBase(rName string) string {
+	return composeConfig(
+		testAccConfigConformancePackConfigBase(rName),
+		`
+resource "aws_organizations_organization" "test" {
+  aws_service_access_principals = ["config-multiaccountsetup.amazonaws.com"]
+  feature_set                   = "ALL"
+}
+`)
+}
+
+func testAccConfigOrganizationConformancePackConfigBasic(rName string) string {
+	return composeConfig(
+		testAccConfigOrganizationConformancePackConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_config_organization_conformance_pack" "test" {
+  depends_on = [aws_config_configuration_recorder.test, aws_organizations_organization.test]
+
+  name = %[1]q
+
+  template_body = <<EOT
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+EOT
+}
+`, rName))
+}
+
+func testAccConfigOrganizationConformancePackConfigExcludedAccounts(rName, excludedAccounts string) string {
+	return composeConfig(
+		testAccConfigOrganizationConformancePackConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_config_organization_conformance_pack" "test" {
+  depends_on = [aws_config_configuration_recorder.test, aws_organizations_organization.test]
+
+  excluded_accounts = [%[2]s]
+  name              = %[1]q
+
+  template_body = <<EOT
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+EOT
+}
+`, rName, excludedAccounts))
+}
+
+func testAccConfigOrganizationConformancePackConfigInputParameter(rName, key, value string) string {
+	return composeConfig(
+		testAccConfigOrganizationConformancePackConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_config_organization_conformance_pack" "test" {
+  depends_on = [aws_config_configuration_recorder.test, aws_organizations_organization.test]
+
+  name = %[1]q
+
+  input_parameter {
+    parameter_name  = %[2]q
+    parameter_value = %[3]q
+  }
+
+  template_body = <<EOT
+Parameters:
+  %[2]s:
+    Type: String
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+EOT
+}
+`, rName, key, value))
+}
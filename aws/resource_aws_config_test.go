@@ -17,6 +17,13 @@ func TestAccAWSConfig_serial(t *testing.T) {
 			"scopeTagValue":    testAccConfigConfigRule_Scope_TagValue,
 			"tags":             testAccConfigConfigRule_tags,
 		},
+		"ConformancePack": {
+			"basic":           testAccConfigConformancePack_basic,
+			"disappears":      testAccConfigConformancePack_disappears,
+			"InputParameters": testAccConfigConformancePack_InputParameters,
+			"S3Delivery":      testAccConfigConformancePack_S3Delivery,
+			"S3Template":      testAccConfigConformancePack_S3Template,
+		},
 		"ConfigurationRecorderStatus": {
 			"basic":        testAccConfigConfigurationRecorderStatus_basic,
 			"startEnabled": testAccConfigConfigurationRecorderStatus_startEnabled,
@@ -32,6 +39,12 @@ func TestAccAWSConfig_serial(t *testing.T) {
 			"allParams":   testAccConfigDeliveryChannel_allParams,
 			"importBasic": testAccConfigDeliveryChannel_importBasic,
 		},
+		"OrganizationConformancePack": {
+			"basic":            testAccConfigOrganizationConformancePack_basic,
+			"disappears":       testAccConfigOrganizationConformancePack_disappears,
+			"ExcludedAccounts": testAccConfigOrganizationConformancePack_ExcludedAccounts,
+			"InputParameters":  testAccConfigOrganizationConformancePack_InputParameters,
+		},
 		"OrganizationCustomRule": {
 			"basic":                     testAccConfigOrganizationCustomRule_basic,
 			"disappears":                testAccConfigOrganizationCustomRule_disappears,
@@ -34,6 +34,8 @@ func resourceAwsDefaultNetworkAcl() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: networkAclManagedRuleNumberRangeCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -183,6 +185,8 @@ func resourceAwsDefaultNetworkAcl() *schema.Resource {
 				Set: resourceAwsNetworkAclEntryHash,
 			},
 
+			"managed_rule_number_range": networkAclManagedRuleNumberRangeSchema(),
+
 			"tags": tagsSchema(),
 
 			"owner_id": {
@@ -199,7 +203,8 @@ func resourceAwsDefaultNetworkAclCreate(d *schema.ResourceData, meta interface{}
 	// revoke all default and pre-existing rules on the default network acl.
 	// In the UPDATE method, we'll apply only the rules in the configuration.
 	log.Printf("[DEBUG] Revoking default ingress and egress rules for Default Network ACL for %s", d.Id())
-	err := revokeAllNetworkACLEntries(d.Id(), meta)
+	ruleNumbers := expandNetworkAclRuleNumberRange(d.Get("managed_rule_number_range").([]interface{}))
+	err := revokeAllNetworkACLEntries(d.Id(), ruleNumbers, meta)
 	if err != nil {
 		return err
 	}
@@ -289,9 +294,9 @@ func resourceAwsDefaultNetworkAclDelete(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-// revokeAllNetworkACLEntries revoke all ingress and egress rules that the Default
-// Network ACL currently has
-func revokeAllNetworkACLEntries(netaclId string, meta interface{}) error {
+// revokeAllNetworkACLEntries revoke all ingress and egress rules within the
+// given rule number range that the Default Network ACL currently has
+func revokeAllNetworkACLEntries(netaclId string, ruleNumbers *networkAclRuleNumberRange, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
 	resp, err := conn.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{
@@ -316,6 +321,10 @@ func revokeAllNetworkACLEntries(netaclId string, meta interface{}) error {
 			continue
 		}
 
+		if !ruleNumbers.contains(aws.Int64Value(e.RuleNumber)) {
+			continue
+		}
+
 		// track if this is an egress or ingress rule, for logging purposes
 		rt := "ingress"
 		if aws.BoolValue(e.Egress) {
@@ -27,7 +27,7 @@ func resourceAwsDynamoDbTable() *schema.Resource {
 		Update: resourceAwsDynamoDbTableUpdate,
 		Delete: resourceAwsDynamoDbTableDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByARN("dynamodb", arnResourceIDFunc("table", "/"), schema.ImportStatePassthrough),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -21,7 +21,7 @@ func resourceAwsEbsVolume() *schema.Resource {
 		Update: resourceAWSEbsVolumeUpdate,
 		Delete: resourceAwsEbsVolumeDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByARN("ec2", arnResourceIDFunc("volume", "/"), schema.ImportStatePassthrough),
 		},
 
 		Schema: map[string]*schema.Schema{
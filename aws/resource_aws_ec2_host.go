@@ -0,0 +1,256 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+)
+
+func resourceAwsEc2Host() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2HostCreate,
+		Read:   resourceAwsEc2HostRead,
+		Update: resourceAwsEc2HostUpdate,
+		Delete: resourceAwsEc2HostDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"auto_placement": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ec2.AutoPlacementOn,
+				ValidateFunc: validation.StringInSlice(ec2.AutoPlacement_Values(), false),
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"host_recovery": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ec2.HostRecoveryOff,
+				ValidateFunc: validation.StringInSlice(ec2.HostRecovery_Values(), false),
+			},
+
+			"instance_family": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"instance_family", "instance_type"},
+			},
+
+			"instance_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"instance_family", "instance_type"},
+			},
+
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsEc2HostCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.AllocateHostsInput{
+		AutoPlacement:     aws.String(d.Get("auto_placement").(string)),
+		AvailabilityZone:  aws.String(d.Get("availability_zone").(string)),
+		ClientToken:       aws.String(resource.UniqueId()),
+		HostRecovery:      aws.String(d.Get("host_recovery").(string)),
+		Quantity:          aws.Int64(1),
+		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeDedicatedHost),
+	}
+
+	if v, ok := d.GetOk("instance_family"); ok {
+		input.InstanceFamily = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_type"); ok {
+		input.InstanceType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Host: %s", input)
+	output, err := conn.AllocateHosts(input)
+
+	if err != nil {
+		return fmt.Errorf("error allocating EC2 Host: %w", err)
+	}
+
+	if output == nil || len(output.HostIds) == 0 {
+		return fmt.Errorf("error allocating EC2 Host: empty output")
+	}
+
+	d.SetId(aws.StringValue(output.HostIds[0]))
+
+	_, err = waiter.HostCreated(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error waiting for EC2 Host (%s) to become available: %w", d.Id(), err)
+	}
+
+	return resourceAwsEc2HostRead(d, meta)
+}
+
+func resourceAwsEc2HostRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	host, err := finder.HostByID(conn, d.Id())
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidHostIDNotFound) {
+		log.Printf("[WARN] EC2 Host (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Host (%s): %w", d.Id(), err)
+	}
+
+	if host == nil || aws.StringValue(host.State) == ec2.AllocationStateReleased || aws.StringValue(host.State) == ec2.AllocationStateReleasedPermanentFailure {
+		log.Printf("[WARN] EC2 Host (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*AWSClient).region,
+		AccountID: aws.StringValue(host.OwnerId),
+		Resource:  fmt.Sprintf("dedicated-host/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("auto_placement", host.AutoPlacement)
+	d.Set("availability_zone", host.AvailabilityZone)
+	d.Set("host_recovery", host.HostRecovery)
+	if v := host.HostProperties; v != nil {
+		d.Set("instance_family", v.InstanceFamily)
+		d.Set("instance_type", v.InstanceType)
+	}
+	d.Set("owner_id", host.OwnerId)
+
+	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(host.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2HostUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChanges("auto_placement", "host_recovery", "instance_family", "instance_type") {
+		input := &ec2.ModifyHostsInput{
+			HostIds: aws.StringSlice([]string{d.Id()}),
+		}
+
+		if d.HasChange("auto_placement") {
+			input.AutoPlacement = aws.String(d.Get("auto_placement").(string))
+		}
+
+		if d.HasChange("host_recovery") {
+			input.HostRecovery = aws.String(d.Get("host_recovery").(string))
+		}
+
+		if d.HasChange("instance_family") {
+			if v, ok := d.GetOk("instance_family"); ok {
+				input.InstanceFamily = aws.String(v.(string))
+			}
+		}
+
+		if d.HasChange("instance_type") {
+			if v, ok := d.GetOk("instance_type"); ok {
+				input.InstanceType = aws.String(v.(string))
+			}
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 Host: %s", input)
+		output, err := conn.ModifyHosts(input)
+
+		if err != nil {
+			return fmt.Errorf("error modifying EC2 Host (%s): %w", d.Id(), err)
+		}
+
+		if output != nil && len(output.Unsuccessful) > 0 && output.Unsuccessful[0] != nil && output.Unsuccessful[0].Error != nil {
+			return fmt.Errorf("error modifying EC2 Host (%s): %s: %s", d.Id(), aws.StringValue(output.Unsuccessful[0].Error.Code), aws.StringValue(output.Unsuccessful[0].Error.Message))
+		}
+
+		_, err = waiter.HostUpdated(conn, d.Id())
+
+		if err != nil {
+			return fmt.Errorf("error waiting for EC2 Host (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Host (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEc2HostRead(d, meta)
+}
+
+func resourceAwsEc2HostDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[INFO] Releasing EC2 Host (%s)", d.Id())
+	output, err := conn.ReleaseHosts(&ec2.ReleaseHostsInput{
+		HostIds: aws.StringSlice([]string{d.Id()}),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidHostIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error releasing EC2 Host (%s): %w", d.Id(), err)
+	}
+
+	if output != nil && len(output.Unsuccessful) > 0 && output.Unsuccessful[0] != nil && output.Unsuccessful[0].Error != nil {
+		if aws.StringValue(output.Unsuccessful[0].Error.Code) == tfec2.ErrCodeInvalidHostIDNotFound {
+			return nil
+		}
+
+		return fmt.Errorf("error releasing EC2 Host (%s): %s: %s", d.Id(), aws.StringValue(output.Unsuccessful[0].Error.Code), aws.StringValue(output.Unsuccessful[0].Error.Message))
+	}
+
+	_, err = waiter.HostDeleted(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error waiting for EC2 Host (%s) to be released: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,333 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_ec2_host", &resource.Sweeper{
+		Name: "aws_ec2_host",
+		F:    testSweepEc2Hosts,
+		Dependencies: []string{
+			"aws_instance",
+		},
+	})
+}
+
+func testSweepEc2Hosts(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).ec2conn
+	input := &ec2.DescribeHostsInput{}
+	var sweeperErrs *multierror.Error
+
+	err = conn.DescribeHostsPages(input, func(page *ec2.DescribeHostsOutput, isLast bool) bool {
+		if page == nil {
+			return !isLast
+		}
+
+		for _, host := range page.Hosts {
+			r := resourceAwsEc2Host()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(host.HostId))
+			err = r.Delete(d, client)
+
+			if err != nil {
+				log.Printf("[ERROR] %s", err)
+				sweeperErrs = multierror.Append(sweeperErrs, err)
+				continue
+			}
+		}
+
+		return !isLast
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping EC2 Host sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EC2 Hosts: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSEc2Host_basic(t *testing.T) {
+	var host ec2.Host
+	resourceName := "aws_ec2_host.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEc2HostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2HostConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEc2HostExists(resourceName, &host),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`dedicated-host/h-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "auto_placement", "on"),
+					resource.TestCheckResourceAttr(resourceName, "host_recovery", "off"),
+					resource.TestCheckResourceAttr(resourceName, "instance_family", ""),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "a1.large"),
+					testAccCheckResourceAttrAccountID(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2Host_disappears(t *testing.T) {
+	var host ec2.Host
+	resourceName := "aws_ec2_host.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEc2HostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2HostConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEc2HostExists(resourceName, &host),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsEc2Host(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2Host_InstanceFamily(t *testing.T) {
+	var host ec2.Host
+	resourceName := "aws_ec2_host.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEc2HostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2HostConfigInstanceFamily(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEc2HostExists(resourceName, &host),
+					resource.TestCheckResourceAttr(resourceName, "auto_placement", "off"),
+					resource.TestCheckResourceAttr(resourceName, "host_recovery", "on"),
+					resource.TestCheckResourceAttr(resourceName, "instance_family", "c5"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEc2HostConfigInstanceType(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEc2HostExists(resourceName, &host),
+					resource.TestCheckResourceAttr(resourceName, "auto_placement", "on"),
+					resource.TestCheckResourceAttr(resourceName, "host_recovery", "off"),
+					resource.TestCheckResourceAttr(resourceName, "instance_family", ""),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "c5.xlarge"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2Host_Tags(t *testing.T) {
+	var host ec2.Host
+	resourceName := "aws_ec2_host.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEc2HostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2HostConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEc2HostExists(resourceName, &host),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEc2HostConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEc2HostExists(resourceName, &host),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccEc2HostConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEc2HostExists(resourceName, &host),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEc2HostDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_host" {
+			continue
+		}
+
+		host, err := finder.HostByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidHostIDNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if host == nil {
+			continue
+		}
+
+		if state := aws.StringValue(host.State); state != ec2.AllocationStateReleased && state != ec2.AllocationStateReleasedPermanentFailure {
+			return fmt.Errorf("EC2 Host %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckEc2HostExists(n string, v *ec2.Host) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Host ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		host, err := finder.HostByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if host == nil {
+			return fmt.Errorf("EC2 Host %s not found", rs.Primary.ID)
+		}
+
+		*v = *host
+
+		return nil
+	}
+}
+
+func testAccEc2HostConfig() string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), `
+resource "aws_ec2_host" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  instance_type     = "a1.large"
+}
+`)
+}
+
+func testAccEc2HostConfigInstanceFamily(rName string) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_ec2_host" "test" {
+  auto_placement    = "off"
+  availability_zone = data.aws_availability_zones.available.names[0]
+  host_recovery     = "on"
+  instance_family   = "c5"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccEc2HostConfigInstanceType(rName string) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_ec2_host" "test" {
+  auto_placement    = "on"
+  availability_zone = data.aws_availability_zones.available.names[0]
+  host_recovery     = "off"
+  instance_type     = "c5.xlarge"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccEc2HostConfigTags1(tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_ec2_host" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  instance_type     = "a1.large"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccEc2HostConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_ec2_host" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  instance_type     = "a1.large"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
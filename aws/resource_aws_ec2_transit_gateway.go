@@ -100,6 +100,15 @@ func resourceAwsEc2TransitGateway() *schema.Resource {
 				Computed: true,
 			},
 			"tags": tagsSchema(),
+			"transit_gateway_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCIDRNetworkAddress,
+				},
+			},
 			"vpn_ecmp_support": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -135,6 +144,10 @@ func resourceAwsEc2TransitGatewayCreate(d *schema.ResourceData, meta interface{}
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("transit_gateway_cidr_blocks"); ok && v.(*schema.Set).Len() > 0 {
+		input.Options.TransitGatewayCidrBlocks = expandStringSet(v.(*schema.Set))
+	}
+
 	log.Printf("[DEBUG] Creating EC2 Transit Gateway: %s", input)
 	output, err := conn.CreateTransitGateway(input)
 	if err != nil {
@@ -197,6 +210,10 @@ func resourceAwsEc2TransitGatewayRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("transit_gateway_cidr_blocks", aws.StringValueSlice(transitGateway.Options.TransitGatewayCidrBlocks)); err != nil {
+		return fmt.Errorf("error setting transit_gateway_cidr_blocks: %s", err)
+	}
+
 	d.Set("vpn_ecmp_support", transitGateway.Options.VpnEcmpSupport)
 
 	return nil
@@ -239,6 +256,23 @@ func resourceAwsEc2TransitGatewayUpdate(d *schema.ResourceData, meta interface{}
 		transitGatewayModified = true
 		options.VpnEcmpSupport = aws.String(d.Get("vpn_ecmp_support").(string))
 	}
+
+	if d.HasChange("transit_gateway_cidr_blocks") {
+		transitGatewayModified = true
+
+		o, n := d.GetChange("transit_gateway_cidr_blocks")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			options.AddTransitGatewayCidrBlocks = expandStringSet(add)
+		}
+
+		if del := os.Difference(ns); del.Len() > 0 {
+			options.RemoveTransitGatewayCidrBlocks = expandStringSet(del)
+		}
+	}
+
 	if transitGatewayModified {
 		modifyTransitGatewayInput.TransitGatewayId = aws.String(d.Id())
 		modifyTransitGatewayInput.Options = options
@@ -0,0 +1,244 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+)
+
+func resourceAwsEc2TransitGatewayConnect() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TransitGatewayConnectCreate,
+		Read:   resourceAwsEc2TransitGatewayConnectRead,
+		Update: resourceAwsEc2TransitGatewayConnectUpdate,
+		Delete: resourceAwsEc2TransitGatewayConnectDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.ProtocolValueGre,
+				ValidateFunc: validation.StringInSlice(ec2.ProtocolValue_Values(), false),
+			},
+			"tags": tagsSchema(),
+			"transit_gateway_default_route_table_association": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"transit_gateway_default_route_table_propagation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"transit_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"transport_attachment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2TransitGatewayConnectCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	transitGatewayID := d.Get("transit_gateway_id").(string)
+
+	input := &ec2.CreateTransitGatewayConnectInput{
+		Options: &ec2.CreateTransitGatewayConnectRequestOptions{
+			Protocol: aws.String(d.Get("protocol").(string)),
+		},
+		TagSpecifications:                   ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeTransitGatewayAttachment),
+		TransportTransitGatewayAttachmentId: aws.String(d.Get("transport_attachment_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway Connect Attachment: %s", input)
+	output, err := conn.CreateTransitGatewayConnect(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Connect Attachment: %w", err)
+	}
+
+	if output == nil || output.TransitGatewayConnect == nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Connect Attachment: empty response")
+	}
+
+	d.SetId(aws.StringValue(output.TransitGatewayConnect.TransitGatewayAttachmentId))
+
+	if _, err := waiter.TransitGatewayConnectCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Connect Attachment (%s) creation: %w", d.Id(), err)
+	}
+
+	transitGateway, err := ec2DescribeTransitGateway(conn, transitGatewayID)
+
+	if err != nil {
+		return fmt.Errorf("error describing EC2 Transit Gateway (%s): %w", transitGatewayID, err)
+	}
+
+	if transitGateway.Options == nil {
+		return fmt.Errorf("error describing EC2 Transit Gateway (%s): missing options", transitGatewayID)
+	}
+
+	if err := ec2TransitGatewayRouteTableAssociationUpdate(conn, aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId), d.Id(), d.Get("transit_gateway_default_route_table_association").(bool)); err != nil {
+		return fmt.Errorf("error updating EC2 Transit Gateway Attachment (%s) Route Table (%s) association: %w", d.Id(), aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId), err)
+	}
+
+	if err := ec2TransitGatewayRouteTablePropagationUpdate(conn, aws.StringValue(transitGateway.Options.PropagationDefaultRouteTableId), d.Id(), d.Get("transit_gateway_default_route_table_propagation").(bool)); err != nil {
+		return fmt.Errorf("error updating EC2 Transit Gateway Attachment (%s) Route Table (%s) propagation: %w", d.Id(), aws.StringValue(transitGateway.Options.PropagationDefaultRouteTableId), err)
+	}
+
+	return resourceAwsEc2TransitGatewayConnectRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayConnectRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	transitGatewayConnect, err := finder.TransitGatewayConnectByID(conn, d.Id())
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayAttachmentIDNotFound) {
+		log.Printf("[WARN] EC2 Transit Gateway Connect Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Connect Attachment (%s): %w", d.Id(), err)
+	}
+
+	if transitGatewayConnect == nil {
+		log.Printf("[WARN] EC2 Transit Gateway Connect Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if aws.StringValue(transitGatewayConnect.State) == ec2.TransitGatewayAttachmentStateDeleting || aws.StringValue(transitGatewayConnect.State) == ec2.TransitGatewayAttachmentStateDeleted {
+		log.Printf("[WARN] EC2 Transit Gateway Connect Attachment (%s) in deleted state (%s), removing from state", d.Id(), aws.StringValue(transitGatewayConnect.State))
+		d.SetId("")
+		return nil
+	}
+
+	transitGatewayID := aws.StringValue(transitGatewayConnect.TransitGatewayId)
+	transitGateway, err := ec2DescribeTransitGateway(conn, transitGatewayID)
+
+	if err != nil {
+		return fmt.Errorf("error describing EC2 Transit Gateway (%s): %w", transitGatewayID, err)
+	}
+
+	if transitGateway.Options == nil {
+		return fmt.Errorf("error describing EC2 Transit Gateway (%s): missing options", transitGatewayID)
+	}
+
+	transitGatewayAssociationDefaultRouteTableID := aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId)
+	transitGatewayDefaultRouteTableAssociation, err := ec2DescribeTransitGatewayRouteTableAssociation(conn, transitGatewayAssociationDefaultRouteTableID, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error determining EC2 Transit Gateway Attachment (%s) association to Route Table (%s): %w", d.Id(), transitGatewayAssociationDefaultRouteTableID, err)
+	}
+
+	transitGatewayPropagationDefaultRouteTableID := aws.StringValue(transitGateway.Options.PropagationDefaultRouteTableId)
+	transitGatewayDefaultRouteTablePropagation, err := ec2DescribeTransitGatewayRouteTablePropagation(conn, transitGatewayPropagationDefaultRouteTableID, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error determining EC2 Transit Gateway Attachment (%s) propagation to Route Table (%s): %w", d.Id(), transitGatewayPropagationDefaultRouteTableID, err)
+	}
+
+	if transitGatewayConnect.Options != nil {
+		d.Set("protocol", transitGatewayConnect.Options.Protocol)
+	}
+
+	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(transitGatewayConnect.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	d.Set("transit_gateway_default_route_table_association", (transitGatewayDefaultRouteTableAssociation != nil))
+	d.Set("transit_gateway_default_route_table_propagation", (transitGatewayDefaultRouteTablePropagation != nil))
+	d.Set("transit_gateway_id", transitGatewayConnect.TransitGatewayId)
+	d.Set("transport_attachment_id", transitGatewayConnect.TransportTransitGatewayAttachmentId)
+
+	return nil
+}
+
+func resourceAwsEc2TransitGatewayConnectUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChanges("transit_gateway_default_route_table_association", "transit_gateway_default_route_table_propagation") {
+		transitGatewayID := d.Get("transit_gateway_id").(string)
+
+		transitGateway, err := ec2DescribeTransitGateway(conn, transitGatewayID)
+
+		if err != nil {
+			return fmt.Errorf("error describing EC2 Transit Gateway (%s): %w", transitGatewayID, err)
+		}
+
+		if transitGateway.Options == nil {
+			return fmt.Errorf("error describing EC2 Transit Gateway (%s): missing options", transitGatewayID)
+		}
+
+		if d.HasChange("transit_gateway_default_route_table_association") {
+			if err := ec2TransitGatewayRouteTableAssociationUpdate(conn, aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId), d.Id(), d.Get("transit_gateway_default_route_table_association").(bool)); err != nil {
+				return fmt.Errorf("error updating EC2 Transit Gateway Attachment (%s) Route Table (%s) association: %w", d.Id(), aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId), err)
+			}
+		}
+
+		if d.HasChange("transit_gateway_default_route_table_propagation") {
+			if err := ec2TransitGatewayRouteTablePropagationUpdate(conn, aws.StringValue(transitGateway.Options.PropagationDefaultRouteTableId), d.Id(), d.Get("transit_gateway_default_route_table_propagation").(bool)); err != nil {
+				return fmt.Errorf("error updating EC2 Transit Gateway Attachment (%s) Route Table (%s) propagation: %w", d.Id(), aws.StringValue(transitGateway.Options.PropagationDefaultRouteTableId), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Transit Gateway Connect Attachment (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEc2TransitGatewayConnectRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayConnectDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Connect Attachment (%s)", d.Id())
+	_, err := conn.DeleteTransitGatewayConnect(&ec2.DeleteTransitGatewayConnectInput{
+		TransitGatewayAttachmentId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayAttachmentIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Transit Gateway Connect Attachment (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.TransitGatewayConnectDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Connect Attachment (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
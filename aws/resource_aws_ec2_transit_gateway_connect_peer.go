@@ -0,0 +1,217 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+)
+
+func resourceAwsEc2TransitGatewayConnectPeer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TransitGatewayConnectPeerCreate,
+		Read:   resourceAwsEc2TransitGatewayConnectPeerRead,
+		Update: resourceAwsEc2TransitGatewayConnectPeerUpdate,
+		Delete: resourceAwsEc2TransitGatewayConnectPeerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bgp_asn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate4ByteAsn,
+			},
+			"inside_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCIDRNetworkAddress,
+				},
+			},
+			"peer_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"tags": tagsSchema(),
+			"transit_gateway_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"transit_gateway_attachment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2TransitGatewayConnectPeerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.CreateTransitGatewayConnectPeerInput{
+		InsideCidrBlocks:           expandStringSet(d.Get("inside_cidr_blocks").(*schema.Set)),
+		PeerAddress:                aws.String(d.Get("peer_address").(string)),
+		TagSpecifications:          ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeTransitGatewayConnectPeer),
+		TransitGatewayAttachmentId: aws.String(d.Get("transit_gateway_attachment_id").(string)),
+	}
+
+	if v, ok := d.GetOk("bgp_asn"); ok {
+		asn, err := strconv.ParseInt(v.(string), 10, 64)
+
+		if err != nil {
+			return err
+		}
+
+		input.BgpOptions = &ec2.TransitGatewayConnectRequestBgpOptions{
+			PeerAsn: aws.Int64(asn),
+		}
+	}
+
+	if v, ok := d.GetOk("transit_gateway_address"); ok {
+		input.TransitGatewayAddress = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway Connect Peer: %s", input)
+	output, err := conn.CreateTransitGatewayConnectPeer(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Connect Peer: %w", err)
+	}
+
+	if output == nil || output.TransitGatewayConnectPeer == nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Connect Peer: empty response")
+	}
+
+	d.SetId(aws.StringValue(output.TransitGatewayConnectPeer.TransitGatewayConnectPeerId))
+
+	if _, err := waiter.TransitGatewayConnectPeerCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Connect Peer (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsEc2TransitGatewayConnectPeerRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayConnectPeerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	transitGatewayConnectPeer, err := finder.TransitGatewayConnectPeerByID(conn, d.Id())
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayConnectPeerIDNotFound) {
+		log.Printf("[WARN] EC2 Transit Gateway Connect Peer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Connect Peer (%s): %w", d.Id(), err)
+	}
+
+	if transitGatewayConnectPeer == nil {
+		log.Printf("[WARN] EC2 Transit Gateway Connect Peer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if aws.StringValue(transitGatewayConnectPeer.State) == ec2.TransitGatewayConnectPeerStateDeleting || aws.StringValue(transitGatewayConnectPeer.State) == ec2.TransitGatewayConnectPeerStateDeleted {
+		log.Printf("[WARN] EC2 Transit Gateway Connect Peer (%s) in deleted state (%s), removing from state", d.Id(), aws.StringValue(transitGatewayConnectPeer.State))
+		d.SetId("")
+		return nil
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("transit-gateway-connect-peer/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+
+	if v := transitGatewayConnectPeer.ConnectPeerConfiguration; v != nil {
+		if len(v.BgpConfigurations) > 0 && v.BgpConfigurations[0] != nil {
+			d.Set("bgp_asn", strconv.FormatInt(aws.Int64Value(v.BgpConfigurations[0].PeerAsn), 10))
+		}
+
+		if err := d.Set("inside_cidr_blocks", aws.StringValueSlice(v.InsideCidrBlocks)); err != nil {
+			return fmt.Errorf("error setting inside_cidr_blocks: %w", err)
+		}
+
+		d.Set("peer_address", v.PeerAddress)
+		d.Set("transit_gateway_address", v.TransitGatewayAddress)
+	}
+
+	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(transitGatewayConnectPeer.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	d.Set("transit_gateway_attachment_id", transitGatewayConnectPeer.TransitGatewayAttachmentId)
+
+	return nil
+}
+
+func resourceAwsEc2TransitGatewayConnectPeerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Transit Gateway Connect Peer (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEc2TransitGatewayConnectPeerRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayConnectPeerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Connect Peer (%s)", d.Id())
+	_, err := conn.DeleteTransitGatewayConnectPeer(&ec2.DeleteTransitGatewayConnectPeerInput{
+		TransitGatewayConnectPeerId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayConnectPeerIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Transit Gateway Connect Peer (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.TransitGatewayConnectPeerDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Connect Peer (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+)
+
+func TestAccAWSEc2TransitGatewayConnectPeer_basic(t *testing.T) {
+	var transitGatewayConnectPeer1 ec2.TransitGatewayConnectPeer
+	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	transitGatewayConnectResourceName := "aws_ec2_transit_gateway_connect.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayConnectPeerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayConnectPeerConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayConnectPeerExists(resourceName, &transitGatewayConnectPeer1),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`transit-gateway-connect-peer/tgw-connect-peer-.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "bgp_asn"),
+					resource.TestCheckResourceAttr(resourceName, "inside_cidr_blocks.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "inside_cidr_blocks.*", "169.254.200.0/29"),
+					resource.TestCheckResourceAttr(resourceName, "peer_address", "1.1.1.1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "transit_gateway_address"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_attachment_id", transitGatewayConnectResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TransitGatewayConnectPeer_disappears(t *testing.T) {
+	var transitGatewayConnectPeer1 ec2.TransitGatewayConnectPeer
+	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayConnectPeerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayConnectPeerConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayConnectPeerExists(resourceName, &transitGatewayConnectPeer1),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsEc2TransitGatewayConnectPeer(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TransitGatewayConnectPeer_BgpAsnAndTransitGatewayAddress(t *testing.T) {
+	var transitGatewayConnectPeer1 ec2.TransitGatewayConnectPeer
+	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayConnectPeerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayConnectPeerConfigBgpAsnAndTransitGatewayAddress(rName, "4200000000", "10.20.30.40"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayConnectPeerExists(resourceName, &transitGatewayConnectPeer1),
+					resource.TestCheckResourceAttr(resourceName, "bgp_asn", "4200000000"),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_address", "10.20.30.40"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2TransitGatewayConnectPeerExists(resourceName string, transitGatewayConnectPeer *ec2.TransitGatewayConnectPeer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Transit Gateway Connect Peer ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		peer, err := finder.TransitGatewayConnectPeerByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if peer == nil {
+			return fmt.Errorf("EC2 Transit Gateway Connect Peer not found")
+		}
+
+		*transitGatewayConnectPeer = *peer
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2TransitGatewayConnectPeerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_transit_gateway_connect_peer" {
+			continue
+		}
+
+		peer, err := finder.TransitGatewayConnectPeerByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayConnectPeerIDNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if peer == nil {
+			continue
+		}
+
+		if state := aws.StringValue(peer.State); state != ec2.TransitGatewayConnectPeerStateDeleted {
+			return fmt.Errorf("EC2 Transit Gateway Connect Peer (%s) still exists in non-deleted (%s) state", rs.Primary.ID, state)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSEc2TransitGatewayConnectPeerConfigBase(rName string) string {
+	return composeConfig(testAccAvailableAZsNoOptInDefaultExcludeConfig(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  transit_gateway_cidr_blocks = ["10.20.30.0/24"]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  subnet_ids         = [aws_subnet.test.id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_connect" "test" {
+  transit_gateway_id      = aws_ec2_transit_gateway.test.id
+  transport_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccAWSEc2TransitGatewayConnectPeerConfig(rName string) string {
+	return composeConfig(testAccAWSEc2TransitGatewayConnectPeerConfigBase(rName), `
+resource "aws_ec2_transit_gateway_connect_peer" "test" {
+  inside_cidr_blocks            = ["169.254.200.0/29"]
+  peer_address                  = "1.1.1.1"
+  transit_gateway_attachment_id = aws_ec2_transit_gateway_connect.test.id
+}
+`)
+}
+
+func testAccAWSEc2TransitGatewayConnectPeerConfigBgpAsnAndTransitGatewayAddress(rName, bgpAsn, transitGatewayAddress string) string {
+	return composeConfig(testAccAWSEc2TransitGatewayConnectPeerConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_connect_peer" "test" {
+  bgp_asn                       = %[1]q
+  inside_cidr_blocks            = ["169.254.200.0/29"]
+  peer_address                  = "1.1.1.1"
+  transit_gateway_address       = %[2]q
+  transit_gateway_attachment_id = aws_ec2_transit_gateway_connect.test.id
+}
+`, bgpAsn, transitGatewayAddress))
+}
@@ -0,0 +1,293 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+)
+
+func TestAccAWSEc2TransitGatewayConnect_basic(t *testing.T) {
+	var transitGatewayConnect1 ec2.TransitGatewayConnect
+	resourceName := "aws_ec2_transit_gateway_connect.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+	transitGatewayVpcAttachmentResourceName := "aws_ec2_transit_gateway_vpc_attachment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayConnectConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayConnectExists(resourceName, &transitGatewayConnect1),
+					resource.TestCheckResourceAttr(resourceName, "protocol", ec2.ProtocolValueGre),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_default_route_table_association", "true"),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_default_route_table_propagation", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", transitGatewayResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transport_attachment_id", transitGatewayVpcAttachmentResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TransitGatewayConnect_disappears(t *testing.T) {
+	var transitGatewayConnect1 ec2.TransitGatewayConnect
+	resourceName := "aws_ec2_transit_gateway_connect.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayConnectConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayConnectExists(resourceName, &transitGatewayConnect1),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsEc2TransitGatewayConnect(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TransitGatewayConnect_Tags(t *testing.T) {
+	var transitGatewayConnect1, transitGatewayConnect2, transitGatewayConnect3 ec2.TransitGatewayConnect
+	resourceName := "aws_ec2_transit_gateway_connect.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayConnectConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayConnectExists(resourceName, &transitGatewayConnect1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2TransitGatewayConnectConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayConnectExists(resourceName, &transitGatewayConnect2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSEc2TransitGatewayConnectConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayConnectExists(resourceName, &transitGatewayConnect3),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TransitGatewayConnect_TransitGatewayDefaultRouteTableAssociationAndPropagation(t *testing.T) {
+	var transitGatewayConnect1, transitGatewayConnect2 ec2.TransitGatewayConnect
+	resourceName := "aws_ec2_transit_gateway_connect.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayConnectConfigTransitGatewayDefaultRouteTableAssociationAndPropagation(rName, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayConnectExists(resourceName, &transitGatewayConnect1),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_default_route_table_association", "false"),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_default_route_table_propagation", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2TransitGatewayConnectConfigTransitGatewayDefaultRouteTableAssociationAndPropagation(rName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayConnectExists(resourceName, &transitGatewayConnect2),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_default_route_table_association", "true"),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_default_route_table_propagation", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2TransitGatewayConnectExists(resourceName string, transitGatewayConnect *ec2.TransitGatewayConnect) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Transit Gateway Connect Attachment ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		attachment, err := finder.TransitGatewayConnectByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if attachment == nil {
+			return fmt.Errorf("EC2 Transit Gateway Connect Attachment not found")
+		}
+
+		*transitGatewayConnect = *attachment
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2TransitGatewayConnectDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_transit_gateway_connect" {
+			continue
+		}
+
+		attachment, err := finder.TransitGatewayConnectByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayAttachmentIDNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if attachment == nil {
+			continue
+		}
+
+		if state := aws.StringValue(attachment.State); state != ec2.TransitGatewayAttachmentStateDeleted {
+			return fmt.Errorf("EC2 Transit Gateway Connect Attachment (%s) still exists in non-deleted (%s) state", rs.Primary.ID, state)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSEc2TransitGatewayConnectConfigBase(rName string) string {
+	return composeConfig(testAccAvailableAZsNoOptInDefaultExcludeConfig(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  subnet_ids         = [aws_subnet.test.id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccAWSEc2TransitGatewayConnectConfig(rName string) string {
+	return composeConfig(testAccAWSEc2TransitGatewayConnectConfigBase(rName), `
+resource "aws_ec2_transit_gateway_connect" "test" {
+  transit_gateway_id      = aws_ec2_transit_gateway.test.id
+  transport_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+}
+`)
+}
+
+func testAccAWSEc2TransitGatewayConnectConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSEc2TransitGatewayConnectConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_connect" "test" {
+  transit_gateway_id      = aws_ec2_transit_gateway.test.id
+  transport_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccAWSEc2TransitGatewayConnectConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSEc2TransitGatewayConnectConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_connect" "test" {
+  transit_gateway_id      = aws_ec2_transit_gateway.test.id
+  transport_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccAWSEc2TransitGatewayConnectConfigTransitGatewayDefaultRouteTableAssociationAndPropagation(rName string, association, propagation bool) string {
+	return composeConfig(testAccAWSEc2TransitGatewayConnectConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_connect" "test" {
+  transit_gateway_default_route_table_association = %[1]t
+  transit_gateway_default_route_table_propagation = %[2]t
+  transit_gateway_id                              = aws_ec2_transit_gateway.test.id
+  transport_attachment_id                         = aws_ec2_transit_gateway_vpc_attachment.test.id
+}
+`, association, propagation))
+}
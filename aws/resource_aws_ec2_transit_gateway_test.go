@@ -132,6 +132,7 @@ func TestAccAWSEc2TransitGateway_basic(t *testing.T) {
 					testAccCheckResourceAttrAccountID(resourceName, "owner_id"),
 					resource.TestCheckResourceAttrSet(resourceName, "propagation_default_route_table_id"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_cidr_blocks.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "vpn_ecmp_support", ec2.VpnEcmpSupportValueEnable),
 				),
 			},
@@ -372,6 +373,42 @@ func TestAccAWSEc2TransitGateway_DnsSupport(t *testing.T) {
 	})
 }
 
+func TestAccAWSEc2TransitGateway_TransitGatewayCidrBlocks(t *testing.T) {
+	var transitGateway1, transitGateway2 ec2.TransitGateway
+	resourceName := "aws_ec2_transit_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayConfigTransitGatewayCidrBlocks1("10.120.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayExists(resourceName, &transitGateway1),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_cidr_blocks.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "transit_gateway_cidr_blocks.*", "10.120.0.0/24"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2TransitGatewayConfigTransitGatewayCidrBlocks2("10.120.0.0/24", "10.121.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayExists(resourceName, &transitGateway2),
+					testAccCheckAWSEc2TransitGatewayNotRecreated(&transitGateway1, &transitGateway2),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_cidr_blocks.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "transit_gateway_cidr_blocks.*", "10.120.0.0/24"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "transit_gateway_cidr_blocks.*", "10.121.0.0/24"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSEc2TransitGateway_VpnEcmpSupport(t *testing.T) {
 	var transitGateway1, transitGateway2 ec2.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -719,6 +756,22 @@ resource "aws_ec2_transit_gateway" "test" {
 `, dnsSupport)
 }
 
+func testAccAWSEc2TransitGatewayConfigTransitGatewayCidrBlocks1(cidrBlock1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  transit_gateway_cidr_blocks = [%[1]q]
+}
+`, cidrBlock1)
+}
+
+func testAccAWSEc2TransitGatewayConfigTransitGatewayCidrBlocks2(cidrBlock1, cidrBlock2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  transit_gateway_cidr_blocks = [%[1]q, %[2]q]
+}
+`, cidrBlock1, cidrBlock2)
+}
+
 func testAccAWSEc2TransitGatewayConfigVpnEcmpSupport(vpnEcmpSupport string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_transit_gateway" "test" {
@@ -20,7 +20,7 @@ func resourceAwsEcrRepository() *schema.Resource {
 		Update: resourceAwsEcrRepositoryUpdate,
 		Delete: resourceAwsEcrRepositoryDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByARN("ecr", arnResourceIDFunc("repository", "/"), schema.ImportStatePassthrough),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
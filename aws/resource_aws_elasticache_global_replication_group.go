@@ -0,0 +1,252 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/waiter"
+)
+
+func resourceAwsElasticacheGlobalReplicationGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsElasticacheGlobalReplicationGroupCreate,
+		Read:   resourceAwsElasticacheGlobalReplicationGroupRead,
+		Update: resourceAwsElasticacheGlobalReplicationGroupUpdate,
+		Delete: resourceAwsElasticacheGlobalReplicationGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				re := regexp.MustCompile("^" + elasticacheGlobalReplicationGroupRegionPrefixFormat)
+				d.Set("global_replication_group_id_suffix", re.ReplaceAllLiteralString(d.Id(), ""))
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"at_rest_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"auth_token_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"cache_node_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version_actual": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"global_replication_group_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"global_replication_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"global_replication_group_id_suffix": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"primary_replication_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"transit_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsElasticacheGlobalReplicationGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	input := &elasticache.CreateGlobalReplicationGroupInput{
+		GlobalReplicationGroupIdSuffix: aws.String(d.Get("global_replication_group_id_suffix").(string)),
+		PrimaryReplicationGroupId:      aws.String(d.Get("primary_replication_group_id").(string)),
+	}
+
+	if v, ok := d.GetOk("global_replication_group_description"); ok {
+		input.GlobalReplicationGroupDescription = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating ElastiCache Global Replication Group: %s", input)
+	output, err := conn.CreateGlobalReplicationGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating ElastiCache Global Replication Group: %w", err)
+	}
+
+	if output == nil || output.GlobalReplicationGroup == nil {
+		return fmt.Errorf("error creating ElastiCache Global Replication Group: empty response")
+	}
+
+	d.SetId(aws.StringValue(output.GlobalReplicationGroup.GlobalReplicationGroupId))
+
+	if _, err := waiter.GlobalReplicationGroupAvailable(conn, d.Id(), waiter.GlobalReplicationGroupAvailableTimeout); err != nil {
+		return fmt.Errorf("error waiting for ElastiCache Global Replication Group (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsElasticacheGlobalReplicationGroupRead(d, meta)
+}
+
+func resourceAwsElasticacheGlobalReplicationGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	globalReplicationGroup, err := finder.GlobalReplicationGroupByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+		log.Printf("[WARN] ElastiCache Global Replication Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ElastiCache Global Replication Group (%s): %w", d.Id(), err)
+	}
+
+	if globalReplicationGroup == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading ElastiCache Global Replication Group (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] ElastiCache Global Replication Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if !d.IsNewResource() && aws.StringValue(globalReplicationGroup.Status) == waiter.GlobalReplicationGroupStatusDeleting {
+		log.Printf("[WARN] ElastiCache Global Replication Group (%s) in deleting state, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", globalReplicationGroup.ARN)
+	d.Set("at_rest_encryption_enabled", globalReplicationGroup.AtRestEncryptionEnabled)
+	d.Set("auth_token_enabled", globalReplicationGroup.AuthTokenEnabled)
+	d.Set("cache_node_type", globalReplicationGroup.CacheNodeType)
+	d.Set("cluster_enabled", globalReplicationGroup.ClusterEnabled)
+	d.Set("engine", globalReplicationGroup.Engine)
+	d.Set("engine_version_actual", globalReplicationGroup.EngineVersion)
+	d.Set("global_replication_group_description", globalReplicationGroup.GlobalReplicationGroupDescription)
+	d.Set("global_replication_group_id", globalReplicationGroup.GlobalReplicationGroupId)
+	d.Set("transit_encryption_enabled", globalReplicationGroup.TransitEncryptionEnabled)
+
+	d.Set("primary_replication_group_id", nil)
+	for _, member := range globalReplicationGroup.Members {
+		if member == nil {
+			continue
+		}
+
+		if aws.StringValue(member.Role) == waiter.GlobalReplicationGroupMemberRolePrimary {
+			d.Set("primary_replication_group_id", member.ReplicationGroupId)
+			break
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsElasticacheGlobalReplicationGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	if d.HasChange("global_replication_group_description") {
+		input := &elasticache.ModifyGlobalReplicationGroupInput{
+			ApplyImmediately:                  aws.Bool(true),
+			GlobalReplicationGroupDescription: aws.String(d.Get("global_replication_group_description").(string)),
+			GlobalReplicationGroupId:          aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating ElastiCache Global Replication Group: %s", input)
+		if _, err := conn.ModifyGlobalReplicationGroup(input); err != nil {
+			return fmt.Errorf("error updating ElastiCache Global Replication Group (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.GlobalReplicationGroupAvailable(conn, d.Id(), waiter.GlobalReplicationGroupAvailableTimeout); err != nil {
+			return fmt.Errorf("error waiting for ElastiCache Global Replication Group (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsElasticacheGlobalReplicationGroupRead(d, meta)
+}
+
+func resourceAwsElasticacheGlobalReplicationGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	input := &elasticache.DeleteGlobalReplicationGroupInput{
+		GlobalReplicationGroupId:      aws.String(d.Id()),
+		RetainPrimaryReplicationGroup: aws.Bool(true),
+	}
+
+	// Secondary members are disassociated asynchronously by their own resources, so retry
+	// while the Global Replication Group is still modifying or has remaining members.
+	log.Printf("[DEBUG] Deleting ElastiCache Global Replication Group (%s)", d.Id())
+	err := resource.Retry(elasticacheGlobalReplicationGroupDeleteRetryTimeout, func() *resource.RetryError {
+		_, err := conn.DeleteGlobalReplicationGroup(input)
+
+		if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeInvalidGlobalReplicationGroupStateFault) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.DeleteGlobalReplicationGroup(input)
+	}
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting ElastiCache Global Replication Group (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.GlobalReplicationGroupDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for ElastiCache Global Replication Group (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const (
+	elasticacheGlobalReplicationGroupDeleteRetryTimeout = 20 * time.Minute
+
+	// Global Replication Group IDs are the requested suffix prefixed by a region-specific prefix, e.g. "ldgnf-".
+	elasticacheGlobalReplicationGroupRegionPrefixFormat = "[[:alpha:]]{5}-"
+)
@@ -0,0 +1,250 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
+)
+
+func TestAccAWSElasticacheGlobalReplicationGroup_basic(t *testing.T) {
+	var globalReplicationGroup elasticache.GlobalReplicationGroup
+	var primaryReplicationGroup elasticache.ReplicationGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_elasticache_global_replication_group.test"
+	primaryReplicationGroupResourceName := "aws_elasticache_replication_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSElasticacheGlobalReplicationGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSElasticacheGlobalReplicationGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheGlobalReplicationGroupExists(resourceName, &globalReplicationGroup),
+					testAccCheckAWSElasticacheReplicationGroupExists(primaryReplicationGroupResourceName, &primaryReplicationGroup),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "elasticache", regexp.MustCompile(`globalreplicationgroup:\w{5}-`+rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "at_rest_encryption_enabled", primaryReplicationGroupResourceName, "at_rest_encryption_enabled"),
+					resource.TestCheckResourceAttr(resourceName, "auth_token_enabled", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "cache_node_type", primaryReplicationGroupResourceName, "node_type"),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_enabled", primaryReplicationGroupResourceName, "cluster_enabled"),
+					resource.TestCheckResourceAttrPair(resourceName, "engine", primaryReplicationGroupResourceName, "engine"),
+					resource.TestCheckResourceAttrPair(resourceName, "engine_version_actual", primaryReplicationGroupResourceName, "engine_version"),
+					resource.TestCheckResourceAttr(resourceName, "global_replication_group_description", ""),
+					resource.TestMatchResourceAttr(resourceName, "global_replication_group_id", regexp.MustCompile(`^\w{5}-`+rName+`$`)),
+					resource.TestCheckResourceAttr(resourceName, "global_replication_group_id_suffix", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_replication_group_id", primaryReplicationGroupResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_encryption_enabled", primaryReplicationGroupResourceName, "transit_encryption_enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSElasticacheGlobalReplicationGroup_disappears(t *testing.T) {
+	var globalReplicationGroup elasticache.GlobalReplicationGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_elasticache_global_replication_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSElasticacheGlobalReplicationGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSElasticacheGlobalReplicationGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheGlobalReplicationGroupExists(resourceName, &globalReplicationGroup),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsElasticacheGlobalReplicationGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSElasticacheGlobalReplicationGroup_Description(t *testing.T) {
+	var globalReplicationGroup elasticache.GlobalReplicationGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_elasticache_global_replication_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSElasticacheGlobalReplicationGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSElasticacheGlobalReplicationGroupConfigDescription(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheGlobalReplicationGroupExists(resourceName, &globalReplicationGroup),
+					resource.TestCheckResourceAttr(resourceName, "global_replication_group_description", "description1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSElasticacheGlobalReplicationGroupConfigDescription(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheGlobalReplicationGroupExists(resourceName, &globalReplicationGroup),
+					resource.TestCheckResourceAttr(resourceName, "global_replication_group_description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSElasticacheGlobalReplicationGroup_SecondaryReplicationGroup(t *testing.T) {
+	var providers []*schema.Provider
+	var globalReplicationGroup elasticache.GlobalReplicationGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_elasticache_global_replication_group.test"
+	secondaryReplicationGroupResourceName := "aws_elasticache_replication_group.secondary"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionPreCheck(t, 2)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAWSElasticacheGlobalReplicationGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSElasticacheGlobalReplicationGroupConfigSecondaryReplicationGroup(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheGlobalReplicationGroupExists(resourceName, &globalReplicationGroup),
+					resource.TestCheckResourceAttrPair(secondaryReplicationGroupResourceName, "global_replication_group_id", resourceName, "global_replication_group_id"),
+					resource.TestCheckResourceAttrPair(secondaryReplicationGroupResourceName, "node_type", resourceName, "cache_node_type"),
+				),
+			},
+			{
+				Config:            testAccAWSElasticacheGlobalReplicationGroupConfigSecondaryReplicationGroup(rName),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSElasticacheGlobalReplicationGroupExists(resourceName string, v *elasticache.GlobalReplicationGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ElastiCache Global Replication Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+
+		globalReplicationGroup, err := finder.GlobalReplicationGroupByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if globalReplicationGroup == nil {
+			return fmt.Errorf("ElastiCache Global Replication Group (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *globalReplicationGroup
+
+		return nil
+	}
+}
+
+func testAccCheckAWSElasticacheGlobalReplicationGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_elasticache_global_replication_group" {
+			continue
+		}
+
+		globalReplicationGroup, err := finder.GlobalReplicationGroupByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if globalReplicationGroup != nil {
+			return fmt.Errorf("ElastiCache Global Replication Group (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSElasticacheGlobalReplicationGroupConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_replication_group" "test" {
+  replication_group_id          = %[1]q
+  replication_group_description = "test"
+  engine                        = "redis"
+  engine_version                = "5.0.6"
+  node_type                     = "cache.m5.large"
+  number_cache_clusters         = 1
+}
+`, rName)
+}
+
+func testAccAWSElasticacheGlobalReplicationGroupConfig(rName string) string {
+	return composeConfig(
+		testAccAWSElasticacheGlobalReplicationGroupConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_elasticache_global_replication_group" "test" {
+  global_replication_group_id_suffix = %[1]q
+  primary_replication_group_id       = aws_elasticache_replication_group.test.id
+}
+`, rName))
+}
+
+func testAccAWSElasticacheGlobalReplicationGroupConfigDescription(rName, description string) string {
+	return composeConfig(
+		testAccAWSElasticacheGlobalReplicationGroupConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_elasticache_global_replication_group" "test" {
+  global_replication_group_id_suffix   = %[1]q
+  global_replication_group_description = %[2]q
+  primary_replication_group_id         = aws_elasticache_replication_group.test.id
+}
+`, rName, description))
+}
+
+func testAccAWSElasticacheGlobalReplicationGroupConfigSecondaryReplicationGroup(rName string) string {
+	return composeConfig(
+		testAccMultipleRegionProviderConfig(2),
+		testAccAWSElasticacheGlobalReplicationGroupConfig(rName),
+		fmt.Sprintf(`
+resource "aws_elasticache_replication_group" "secondary" {
+  provider = "awsalternate"
+
+  replication_group_id          = "%[1]s-secondary"
+  replication_group_description = "test secondary"
+  global_replication_group_id   = aws_elasticache_global_replication_group.test.global_replication_group_id
+  number_cache_clusters         = 1
+}
+`, rName))
+}
@@ -10,11 +10,14 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/waiter"
 )
 
 func resourceAwsElasticacheReplicationGroup() *schema.Resource {
@@ -108,6 +111,12 @@ func resourceAwsElasticacheReplicationGroup() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"global_replication_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
 			"maintenance_window": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -245,6 +254,12 @@ func resourceAwsElasticacheReplicationGroup() *schema.Resource {
 				Default:  false,
 				ForceNew: true,
 			},
+			"user_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 			"kms_key_id": {
 				Type:     schema.TypeString,
 				ForceNew: true,
@@ -277,11 +292,17 @@ func resourceAwsElasticacheReplicationGroupCreate(d *schema.ResourceData, meta i
 		ReplicationGroupDescription: aws.String(d.Get("replication_group_description").(string)),
 		AutomaticFailoverEnabled:    aws.Bool(d.Get("automatic_failover_enabled").(bool)),
 		AutoMinorVersionUpgrade:     aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
-		CacheNodeType:               aws.String(d.Get("node_type").(string)),
-		Engine:                      aws.String(d.Get("engine").(string)),
 		Tags:                        tags,
 	}
 
+	// Secondary members of a Global Replication Group inherit the engine and node type of the primary.
+	if v, ok := d.GetOk("global_replication_group_id"); ok {
+		params.GlobalReplicationGroupId = aws.String(v.(string))
+	} else {
+		params.CacheNodeType = aws.String(d.Get("node_type").(string))
+		params.Engine = aws.String(d.Get("engine").(string))
+	}
+
 	if v, ok := d.GetOk("engine_version"); ok {
 		params.EngineVersion = aws.String(v.(string))
 	}
@@ -350,6 +371,10 @@ func resourceAwsElasticacheReplicationGroupCreate(d *schema.ResourceData, meta i
 		params.AuthToken = aws.String(v.(string))
 	}
 
+	if v := d.Get("user_group_ids").(*schema.Set); v.Len() > 0 {
+		params.UserGroupIds = expandStringSet(v)
+	}
+
 	if clusterMode, ok := d.GetOk("cluster_mode"); ok {
 		clusterModeList := clusterMode.([]interface{})
 		attributes := clusterModeList[0].(map[string]interface{})
@@ -455,6 +480,16 @@ func resourceAwsElasticacheReplicationGroupRead(d *schema.ResourceData, meta int
 	d.Set("cluster_enabled", rgp.ClusterEnabled)
 	d.Set("replication_group_id", rgp.ReplicationGroupId)
 
+	if rgp.GlobalReplicationGroupInfo != nil {
+		d.Set("global_replication_group_id", rgp.GlobalReplicationGroupInfo.GlobalReplicationGroupId)
+	} else {
+		d.Set("global_replication_group_id", nil)
+	}
+
+	if err := d.Set("user_group_ids", flattenStringSet(rgp.UserGroupIds)); err != nil {
+		return fmt.Errorf("error setting user_group_ids: %w", err)
+	}
+
 	if rgp.NodeGroups != nil {
 		if len(rgp.NodeGroups[0].NodeGroupMembers) == 0 {
 			return nil
@@ -802,6 +837,26 @@ func resourceAwsElasticacheReplicationGroupUpdate(d *schema.ResourceData, meta i
 		requestUpdate = true
 	}
 
+	if d.HasChange("user_group_ids") {
+		o, n := d.GetChange("user_group_ids")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		if ns.Len() == 0 {
+			params.RemoveUserGroups = aws.Bool(true)
+		} else {
+			if add := ns.Difference(os); add.Len() > 0 {
+				params.UserGroupIdsToAdd = expandStringSet(add)
+			}
+
+			if del := os.Difference(ns); del.Len() > 0 {
+				params.UserGroupIdsToRemove = expandStringSet(del)
+			}
+		}
+
+		requestUpdate = true
+	}
+
 	if requestUpdate {
 		_, err := conn.ModifyReplicationGroup(params)
 		if err != nil {
@@ -840,6 +895,13 @@ func resourceAwsElasticacheReplicationGroupUpdate(d *schema.ResourceData, meta i
 func resourceAwsElasticacheReplicationGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticacheconn
 
+	// A Replication Group cannot be deleted while it is a secondary member of a Global Replication Group.
+	if v, ok := d.GetOk("global_replication_group_id"); ok {
+		if err := disassociateElasticacheReplicationGroup(conn, v.(string), d.Id(), meta.(*AWSClient).region); err != nil {
+			return fmt.Errorf("error disassociating Elasticache Replication Group (%s) from Global Replication Group (%s): %w", d.Id(), v.(string), err)
+		}
+	}
+
 	err := deleteElasticacheReplicationGroup(d.Id(), conn)
 	if err != nil {
 		return fmt.Errorf("error deleting Elasticache Replication Group (%s): %w", d.Id(), err)
@@ -896,6 +958,62 @@ func cacheReplicationGroupStateRefreshFunc(conn *elasticache.ElastiCache, replic
 	}
 }
 
+func disassociateElasticacheReplicationGroup(conn *elasticache.ElastiCache, globalReplicationGroupID, replicationGroupID, region string) error {
+	member, err := finder.GlobalReplicationGroupMemberByID(conn, globalReplicationGroupID, replicationGroupID)
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// The primary member is removed when the Global Replication Group itself is deleted.
+	if member == nil || aws.StringValue(member.Role) == waiter.GlobalReplicationGroupMemberRolePrimary {
+		return nil
+	}
+
+	input := &elasticache.DisassociateGlobalReplicationGroupInput{
+		GlobalReplicationGroupId: aws.String(globalReplicationGroupID),
+		ReplicationGroupId:       aws.String(replicationGroupID),
+		ReplicationGroupRegion:   aws.String(region),
+	}
+
+	log.Printf("[DEBUG] Disassociating Elasticache Replication Group from Global Replication Group: %s", input)
+	err = resource.Retry(waiter.GlobalReplicationGroupMemberDetachedTimeout, func() *resource.RetryError {
+		_, err := conn.DisassociateGlobalReplicationGroup(input)
+
+		if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeInvalidGlobalReplicationGroupStateFault) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.DisassociateGlobalReplicationGroup(input)
+	}
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waiter.GlobalReplicationGroupMemberDetached(conn, globalReplicationGroupID, replicationGroupID); err != nil {
+		return fmt.Errorf("error waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
 func deleteElasticacheReplicationGroup(replicationGroupID string, conn *elasticache.ElastiCache) error {
 	input := &elasticache.DeleteReplicationGroupInput{
 		ReplicationGroupId: aws.String(replicationGroupID),
@@ -839,6 +839,44 @@ func TestAccAWSElasticacheReplicationGroup_tags(t *testing.T) {
 	})
 }
 
+func TestAccAWSElasticacheReplicationGroup_UserGroupIds(t *testing.T) {
+	var rg elasticache.ReplicationGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_elasticache_replication_group.test"
+	userGroupResourceName1 := "aws_elasticache_user_group.test.0"
+	userGroupResourceName2 := "aws_elasticache_user_group.test.1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSElasticacheReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSElasticacheReplicationGroupConfigUserGroupIds(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheReplicationGroupExists(resourceName, &rg),
+					resource.TestCheckResourceAttr(resourceName, "user_group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "user_group_ids.*", userGroupResourceName1, "user_group_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately"},
+			},
+			{
+				Config: testAccAWSElasticacheReplicationGroupConfigUserGroupIds(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheReplicationGroupExists(resourceName, &rg),
+					resource.TestCheckResourceAttr(resourceName, "user_group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "user_group_ids.*", userGroupResourceName2, "user_group_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestResourceAWSElastiCacheReplicationGroupEngineValidation(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -1761,3 +1799,34 @@ resource "aws_elasticache_replication_group" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccAWSElasticacheReplicationGroupConfigUserGroupIds(rName string, userGroupIndex int) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_user" "test" {
+  user_id              = %[1]q
+  user_name            = "default"
+  access_string        = "on ~* +@all"
+  engine               = "REDIS"
+  no_password_required = true
+}
+
+resource "aws_elasticache_user_group" "test" {
+  count = 2
+
+  user_group_id = "%[1]s-${count.index}"
+  engine        = "REDIS"
+  user_ids      = [aws_elasticache_user.test.user_id]
+}
+
+resource "aws_elasticache_replication_group" "test" {
+  replication_group_id          = %[1]q
+  replication_group_description = "test description"
+  node_type                     = "cache.t3.small"
+  number_cache_clusters         = 1
+  engine_version                = "6.x"
+  apply_immediately             = true
+  transit_encryption_enabled    = true
+  user_group_ids                = [aws_elasticache_user_group.test[%[2]d].user_group_id]
+}
+`, rName, userGroupIndex)
+}
@@ -0,0 +1,206 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/waiter"
+)
+
+func resourceAwsElasticacheUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsElasticacheUserCreate,
+		Read:   resourceAwsElasticacheUserRead,
+		Update: resourceAwsElasticacheUserUpdate,
+		Delete: resourceAwsElasticacheUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_string": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"REDIS"}, true),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+			},
+			"no_password_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"passwords": {
+				Type:      schema.TypeSet,
+				Optional:  true,
+				MaxItems:  2,
+				Sensitive: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(16, 128),
+				},
+			},
+			"user_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceAwsElasticacheUserCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	input := &elasticache.CreateUserInput{
+		AccessString:       aws.String(d.Get("access_string").(string)),
+		Engine:             aws.String(d.Get("engine").(string)),
+		NoPasswordRequired: aws.Bool(d.Get("no_password_required").(bool)),
+		UserId:             aws.String(d.Get("user_id").(string)),
+		UserName:           aws.String(d.Get("user_name").(string)),
+	}
+
+	if v := d.Get("passwords").(*schema.Set); v.Len() > 0 {
+		input.Passwords = expandStringSet(v)
+	}
+
+	// Do not log the input as it contains the passwords.
+	log.Printf("[DEBUG] Creating ElastiCache User: %s", d.Get("user_id").(string))
+	output, err := conn.CreateUser(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating ElastiCache User: %w", err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error creating ElastiCache User: empty response")
+	}
+
+	d.SetId(aws.StringValue(output.UserId))
+
+	if _, err := waiter.UserActive(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for ElastiCache User (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsElasticacheUserRead(d, meta)
+}
+
+func resourceAwsElasticacheUserRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	user, err := finder.UserByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, elasticache.ErrCodeUserNotFoundFault) {
+		log.Printf("[WARN] ElastiCache User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ElastiCache User (%s): %w", d.Id(), err)
+	}
+
+	if user == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading ElastiCache User (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] ElastiCache User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("access_string", user.AccessString)
+	d.Set("arn", user.ARN)
+	d.Set("engine", user.Engine)
+
+	if v := user.Authentication; v != nil {
+		d.Set("no_password_required", aws.StringValue(v.Type) == elasticache.AuthenticationTypeNoPassword)
+	}
+
+	d.Set("user_id", user.UserId)
+	d.Set("user_name", user.UserName)
+
+	return nil
+}
+
+func resourceAwsElasticacheUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	if d.HasChanges("access_string", "no_password_required", "passwords") {
+		input := &elasticache.ModifyUserInput{
+			UserId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("access_string") {
+			input.AccessString = aws.String(d.Get("access_string").(string))
+		}
+
+		if d.HasChange("no_password_required") {
+			input.NoPasswordRequired = aws.Bool(d.Get("no_password_required").(bool))
+		}
+
+		if d.HasChange("passwords") {
+			input.Passwords = expandStringSet(d.Get("passwords").(*schema.Set))
+		}
+
+		log.Printf("[DEBUG] Updating ElastiCache User (%s)", d.Id())
+		if _, err := conn.ModifyUser(input); err != nil {
+			return fmt.Errorf("error updating ElastiCache User (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.UserActive(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for ElastiCache User (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsElasticacheUserRead(d, meta)
+}
+
+func resourceAwsElasticacheUserDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	log.Printf("[DEBUG] Deleting ElastiCache User (%s)", d.Id())
+	_, err := conn.DeleteUser(&elasticache.DeleteUserInput{
+		UserId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeUserNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting ElastiCache User (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.UserDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for ElastiCache User (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
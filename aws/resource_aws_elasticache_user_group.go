@@ -0,0 +1,199 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/waiter"
+)
+
+func resourceAwsElasticacheUserGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsElasticacheUserGroupCreate,
+		Read:   resourceAwsElasticacheUserGroupRead,
+		Update: resourceAwsElasticacheUserGroupUpdate,
+		Delete: resourceAwsElasticacheUserGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"REDIS"}, true),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+			},
+			"user_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"user_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceAwsElasticacheUserGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	input := &elasticache.CreateUserGroupInput{
+		Engine:      aws.String(d.Get("engine").(string)),
+		UserGroupId: aws.String(d.Get("user_group_id").(string)),
+	}
+
+	if v := d.Get("user_ids").(*schema.Set); v.Len() > 0 {
+		input.UserIds = expandStringSet(v)
+	}
+
+	log.Printf("[DEBUG] Creating ElastiCache User Group: %s", input)
+	output, err := conn.CreateUserGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating ElastiCache User Group: %w", err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error creating ElastiCache User Group: empty response")
+	}
+
+	d.SetId(aws.StringValue(output.UserGroupId))
+
+	if _, err := waiter.UserGroupActive(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for ElastiCache User Group (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsElasticacheUserGroupRead(d, meta)
+}
+
+func resourceAwsElasticacheUserGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	userGroup, err := finder.UserGroupByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, elasticache.ErrCodeUserGroupNotFoundFault) {
+		log.Printf("[WARN] ElastiCache User Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ElastiCache User Group (%s): %w", d.Id(), err)
+	}
+
+	if userGroup == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading ElastiCache User Group (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] ElastiCache User Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", userGroup.ARN)
+	d.Set("engine", userGroup.Engine)
+	d.Set("user_group_id", userGroup.UserGroupId)
+
+	if err := d.Set("user_ids", aws.StringValueSlice(userGroup.UserIds)); err != nil {
+		return fmt.Errorf("error setting user_ids: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsElasticacheUserGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	if d.HasChange("user_ids") {
+		o, n := d.GetChange("user_ids")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		input := &elasticache.ModifyUserGroupInput{
+			UserGroupId: aws.String(d.Id()),
+		}
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			input.UserIdsToAdd = expandStringSet(add)
+		}
+
+		if del := os.Difference(ns); del.Len() > 0 {
+			input.UserIdsToRemove = expandStringSet(del)
+		}
+
+		log.Printf("[DEBUG] Updating ElastiCache User Group: %s", input)
+		if _, err := conn.ModifyUserGroup(input); err != nil {
+			return fmt.Errorf("error updating ElastiCache User Group (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.UserGroupActive(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for ElastiCache User Group (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsElasticacheUserGroupRead(d, meta)
+}
+
+func resourceAwsElasticacheUserGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	input := &elasticache.DeleteUserGroupInput{
+		UserGroupId: aws.String(d.Id()),
+	}
+
+	// User Groups cannot be deleted while a Replication Group removing them is still modifying.
+	log.Printf("[DEBUG] Deleting ElastiCache User Group (%s)", d.Id())
+	err := resource.Retry(waiter.UserGroupDeletedTimeout, func() *resource.RetryError {
+		_, err := conn.DeleteUserGroup(input)
+
+		if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeInvalidUserGroupStateFault) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.DeleteUserGroup(input)
+	}
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeUserGroupNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting ElastiCache User Group (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.UserGroupDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for ElastiCache User Group (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
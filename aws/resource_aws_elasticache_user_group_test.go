@@ -0,0 +1,196 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
+)
+
+func TestAccAWSElasticacheUserGroup_basic(t *testing.T) {
+	var userGroup elasticache.UserGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_elasticache_user_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSElasticacheUserGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSElasticacheUserGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheUserGroupExists(resourceName, &userGroup),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "elasticache", fmt.Sprintf("usergroup:%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "engine", "redis"),
+					resource.TestCheckResourceAttr(resourceName, "user_group_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "user_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "user_ids.*", "aws_elasticache_user.test.0", "user_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSElasticacheUserGroup_disappears(t *testing.T) {
+	var userGroup elasticache.UserGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_elasticache_user_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSElasticacheUserGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSElasticacheUserGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheUserGroupExists(resourceName, &userGroup),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsElasticacheUserGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSElasticacheUserGroup_UserIds(t *testing.T) {
+	var userGroup elasticache.UserGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_elasticache_user_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSElasticacheUserGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSElasticacheUserGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheUserGroupExists(resourceName, &userGroup),
+					resource.TestCheckResourceAttr(resourceName, "user_ids.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSElasticacheUserGroupConfigUserIds2(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheUserGroupExists(resourceName, &userGroup),
+					resource.TestCheckResourceAttr(resourceName, "user_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "user_ids.*", "aws_elasticache_user.test.0", "user_id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "user_ids.*", "aws_elasticache_user.test.1", "user_id"),
+				),
+			},
+			{
+				Config: testAccAWSElasticacheUserGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheUserGroupExists(resourceName, &userGroup),
+					resource.TestCheckResourceAttr(resourceName, "user_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSElasticacheUserGroupExists(resourceName string, v *elasticache.UserGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ElastiCache User Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+
+		userGroup, err := finder.UserGroupByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if userGroup == nil {
+			return fmt.Errorf("ElastiCache User Group (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *userGroup
+
+		return nil
+	}
+}
+
+func testAccCheckAWSElasticacheUserGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_elasticache_user_group" {
+			continue
+		}
+
+		userGroup, err := finder.UserGroupByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeUserGroupNotFoundFault) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if userGroup != nil {
+			return fmt.Errorf("ElastiCache User Group (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSElasticacheUserGroupConfigBase(rName string) string {
+	// Every User Group must contain a user named "default".
+	return fmt.Sprintf(`
+resource "aws_elasticache_user" "test" {
+  count = 2
+
+  user_id              = "%[1]s-${count.index}"
+  user_name            = count.index == 0 ? "default" : "%[1]s-${count.index}"
+  access_string        = "on ~app::* -@all +@read"
+  engine               = "REDIS"
+  no_password_required = true
+}
+`, rName)
+}
+
+func testAccAWSElasticacheUserGroupConfig(rName string) string {
+	return composeConfig(
+		testAccAWSElasticacheUserGroupConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_elasticache_user_group" "test" {
+  user_group_id = %[1]q
+  engine        = "REDIS"
+  user_ids      = [aws_elasticache_user.test[0].user_id]
+}
+`, rName))
+}
+
+func testAccAWSElasticacheUserGroupConfigUserIds2(rName string) string {
+	return composeConfig(
+		testAccAWSElasticacheUserGroupConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_elasticache_user_group" "test" {
+  user_group_id = %[1]q
+  engine        = "REDIS"
+  user_ids      = aws_elasticache_user.test[*].user_id
+}
+`, rName))
+}
@@ -0,0 +1,165 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsFsxBackup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFsxBackupCreate,
+		Read:   resourceAwsFsxBackupRead,
+		Update: resourceAwsFsxBackupUpdate,
+		Delete: resourceAwsFsxBackupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"file_system_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsFsxBackupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn
+
+	input := &fsx.CreateBackupInput{
+		ClientRequestToken: aws.String(resource.UniqueId()),
+		FileSystemId:       aws.String(d.Get("file_system_id").(string)),
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().FsxTags()
+	}
+
+	result, err := conn.CreateBackup(input)
+	if err != nil {
+		return fmt.Errorf("error creating FSx Backup: %w", err)
+	}
+
+	d.SetId(aws.StringValue(result.Backup.BackupId))
+
+	log.Println("[DEBUG] Waiting for FSx backup to become available")
+
+	if err := waitForFsxBackupAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for FSx Backup (%s) to become available: %w", d.Id(), err)
+	}
+
+	return resourceAwsFsxBackupRead(d, meta)
+}
+
+func resourceAwsFsxBackupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.FsxUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating FSx Backup (%s) tags: %w", d.Get("arn").(string), err)
+		}
+	}
+
+	return resourceAwsFsxBackupRead(d, meta)
+}
+
+func resourceAwsFsxBackupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	backup, err := describeFsxBackup(conn, d.Id())
+
+	if !d.IsNewResource() && isAWSErr(err, fsx.ErrCodeBackupNotFound, "") {
+		log.Printf("[WARN] FSx Backup (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading FSx Backup (%s): %w", d.Id(), err)
+	}
+
+	if backup == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading FSx Backup (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] FSx Backup (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", backup.ResourceARN)
+	d.Set("type", backup.Type)
+	d.Set("kms_key_id", backup.KmsKeyId)
+
+	if backup.FileSystem != nil {
+		d.Set("file_system_id", backup.FileSystem.FileSystemId)
+		d.Set("owner_id", backup.FileSystem.OwnerId)
+	}
+
+	if err := d.Set("tags", keyvaluetags.FsxKeyValueTags(backup.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsFsxBackupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn
+
+	request := &fsx.DeleteBackupInput{
+		BackupId: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteBackup(request)
+
+	if isAWSErr(err, fsx.ErrCodeBackupNotFound, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting FSx Backup (%s): %w", d.Id(), err)
+	}
+
+	log.Println("[DEBUG] Waiting for FSx backup to delete")
+
+	if err := waitForFsxBackupDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for FSx Backup (%s) to delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSFsxBackup_basic(t *testing.T) {
+	var backup fsx.Backup
+	resourceName := "aws_fsx_backup.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(fsx.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxBackupConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxBackupExists(resourceName, &backup),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "fsx", regexp.MustCompile(`backup/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "file_system_id", "aws_fsx_lustre_file_system.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "kms_key_id"),
+					testAccCheckResourceAttrAccountID(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", fsx.BackupTypeUserInitiated),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxBackup_disappears(t *testing.T) {
+	var backup fsx.Backup
+	resourceName := "aws_fsx_backup.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(fsx.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxBackupConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxBackupExists(resourceName, &backup),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsFsxBackup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxBackup_Tags(t *testing.T) {
+	var backup fsx.Backup
+	resourceName := "aws_fsx_backup.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(fsx.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxBackupConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxBackupExists(resourceName, &backup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsFsxBackupConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxBackupExists(resourceName, &backup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsFsxBackupConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxBackupExists(resourceName, &backup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFsxBackupExists(resourceName string, b *fsx.Backup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).fsxconn
+
+		backup, err := describeFsxBackup(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if backup == nil {
+			return fmt.Errorf("FSx Backup (%s) not found", rs.Primary.ID)
+		}
+
+		*b = *backup
+
+		return nil
+	}
+}
+
+func testAccCheckFsxBackupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).fsxconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_fsx_backup" {
+			continue
+		}
+
+		backup, err := describeFsxBackup(conn, rs.Primary.ID)
+
+		if isAWSErr(err, fsx.ErrCodeBackupNotFound, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if backup != nil {
+			return fmt.Errorf("FSx Backup (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccAwsFsxBackupConfigBase() string {
+	return testAccAwsFsxLustreFileSystemConfigBase() + `
+resource "aws_fsx_lustre_file_system" "test" {
+  storage_capacity            = 1200
+  subnet_ids                  = [aws_subnet.test1.id]
+  deployment_type             = "PERSISTENT_1"
+  per_unit_storage_throughput = 50
+}
+`
+}
+
+func testAccAwsFsxBackupConfigBasic() string {
+	return testAccAwsFsxBackupConfigBase() + `
+resource "aws_fsx_backup" "test" {
+  file_system_id = aws_fsx_lustre_file_system.test.id
+}
+`
+}
+
+func testAccAwsFsxBackupConfigTags1(tagKey1, tagValue1 string) string {
+	return testAccAwsFsxBackupConfigBase() + fmt.Sprintf(`
+resource "aws_fsx_backup" "test" {
+  file_system_id = aws_fsx_lustre_file_system.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAwsFsxBackupConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccAwsFsxBackupConfigBase() + fmt.Sprintf(`
+resource "aws_fsx_backup" "test" {
+  file_system_id = aws_fsx_lustre_file_system.test.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,260 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsFsxDataRepositoryTask() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFsxDataRepositoryTaskCreate,
+		Read:   resourceAwsFsxDataRepositoryTaskRead,
+		Update: resourceAwsFsxDataRepositoryTaskUpdate,
+		Delete: resourceAwsFsxDataRepositoryTaskDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"file_system_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"paths": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 100,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(0, 4096),
+				},
+			},
+			"report": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(fsx.ReportFormat_Values(), false),
+						},
+						"path": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 900),
+						},
+						"scope": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(fsx.ReportScope_Values(), false),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      fsx.DataRepositoryTaskTypeExportToRepository,
+				ValidateFunc: validation.StringInSlice(fsx.DataRepositoryTaskType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsFsxDataRepositoryTaskCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn
+
+	input := &fsx.CreateDataRepositoryTaskInput{
+		ClientRequestToken: aws.String(resource.UniqueId()),
+		FileSystemId:       aws.String(d.Get("file_system_id").(string)),
+		Report:             expandFsxCompletionReport(d.Get("report").([]interface{})),
+		Type:               aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("paths"); ok && len(v.([]interface{})) > 0 {
+		input.Paths = expandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().FsxTags()
+	}
+
+	result, err := conn.CreateDataRepositoryTask(input)
+	if err != nil {
+		return fmt.Errorf("error creating FSx Data Repository Task: %w", err)
+	}
+
+	d.SetId(aws.StringValue(result.DataRepositoryTask.TaskId))
+
+	log.Println("[DEBUG] Waiting for FSx data repository task to complete")
+
+	if err := waitForFsxDataRepositoryTaskCompletion(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for FSx Data Repository Task (%s) to complete: %w", d.Id(), err)
+	}
+
+	return resourceAwsFsxDataRepositoryTaskRead(d, meta)
+}
+
+func resourceAwsFsxDataRepositoryTaskUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.FsxUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating FSx Data Repository Task (%s) tags: %w", d.Get("arn").(string), err)
+		}
+	}
+
+	return resourceAwsFsxDataRepositoryTaskRead(d, meta)
+}
+
+func resourceAwsFsxDataRepositoryTaskRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	task, err := describeFsxDataRepositoryTask(conn, d.Id())
+
+	if !d.IsNewResource() && isAWSErr(err, fsx.ErrCodeDataRepositoryTaskNotFound, "") {
+		log.Printf("[WARN] FSx Data Repository Task (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading FSx Data Repository Task (%s): %w", d.Id(), err)
+	}
+
+	if task == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading FSx Data Repository Task (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] FSx Data Repository Task (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", task.ResourceARN)
+	d.Set("file_system_id", task.FileSystemId)
+	d.Set("status", task.Lifecycle)
+	d.Set("type", task.Type)
+
+	if err := d.Set("paths", aws.StringValueSlice(task.Paths)); err != nil {
+		return fmt.Errorf("error setting paths: %w", err)
+	}
+
+	if err := d.Set("report", flattenFsxCompletionReport(task.Report)); err != nil {
+		return fmt.Errorf("error setting report: %w", err)
+	}
+
+	if err := d.Set("tags", keyvaluetags.FsxKeyValueTags(task.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsFsxDataRepositoryTaskDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn
+
+	// Completed tasks cannot be deleted, only tasks still in progress can be cancelled.
+	_, err := conn.CancelDataRepositoryTask(&fsx.CancelDataRepositoryTaskInput{
+		TaskId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, fsx.ErrCodeDataRepositoryTaskNotFound, "") || isAWSErr(err, fsx.ErrCodeDataRepositoryTaskEnded, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error cancelling FSx Data Repository Task (%s): %w", d.Id(), err)
+	}
+
+	log.Println("[DEBUG] Waiting for FSx data repository task to cancel")
+
+	if err := waitForFsxDataRepositoryTaskCancellation(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for FSx Data Repository Task (%s) to cancel: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandFsxCompletionReport(l []interface{}) *fsx.CompletionReport {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	data := l[0].(map[string]interface{})
+	report := &fsx.CompletionReport{
+		Enabled: aws.Bool(data["enabled"].(bool)),
+	}
+
+	if v, ok := data["format"].(string); ok && v != "" {
+		report.Format = aws.String(v)
+	}
+
+	if v, ok := data["path"].(string); ok && v != "" {
+		report.Path = aws.String(v)
+	}
+
+	if v, ok := data["scope"].(string); ok && v != "" {
+		report.Scope = aws.String(v)
+	}
+
+	return report
+}
+
+func flattenFsxCompletionReport(report *fsx.CompletionReport) []map[string]interface{} {
+	if report == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"enabled": aws.BoolValue(report.Enabled),
+		"format":  aws.StringValue(report.Format),
+		"path":    aws.StringValue(report.Path),
+		"scope":   aws.StringValue(report.Scope),
+	}
+
+	return []map[string]interface{}{m}
+}
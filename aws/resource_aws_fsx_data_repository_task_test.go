@@ -0,0 +1,146 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSFsxDataRepositoryTask_basic(t *testing.T) {
+	var task fsx.DataRepositoryTask
+	resourceName := "aws_fsx_data_repository_task.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(fsx.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxDataRepositoryTaskConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxDataRepositoryTaskExists(resourceName, &task),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "fsx", regexp.MustCompile(`task/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "file_system_id", "aws_fsx_lustre_file_system.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", fsx.DataRepositoryTaskLifecycleSucceeded),
+					resource.TestCheckResourceAttr(resourceName, "paths.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "report.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "report.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", fsx.DataRepositoryTaskTypeExportToRepository),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxDataRepositoryTask_Report(t *testing.T) {
+	var task fsx.DataRepositoryTask
+	resourceName := "aws_fsx_data_repository_task.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(fsx.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxDataRepositoryTaskConfigReport(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxDataRepositoryTaskExists(resourceName, &task),
+					resource.TestCheckResourceAttr(resourceName, "paths.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "paths.0", "export"),
+					resource.TestCheckResourceAttr(resourceName, "report.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "report.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "report.0.format", fsx.ReportFormatReportCsv20191124),
+					resource.TestCheckResourceAttr(resourceName, "report.0.scope", fsx.ReportScopeFailedFilesOnly),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFsxDataRepositoryTaskExists(resourceName string, t *fsx.DataRepositoryTask) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).fsxconn
+
+		task, err := describeFsxDataRepositoryTask(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if task == nil {
+			return fmt.Errorf("FSx Data Repository Task (%s) not found", rs.Primary.ID)
+		}
+
+		*t = *task
+
+		return nil
+	}
+}
+
+func testAccAwsFsxDataRepositoryTaskConfigBase(rName string) string {
+	return testAccAwsFsxLustreFileSystemConfigBase() + fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  acl           = "private"
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_fsx_lustre_file_system" "test" {
+  export_path      = "s3://${aws_s3_bucket.test.bucket}/export"
+  import_path      = "s3://${aws_s3_bucket.test.bucket}"
+  storage_capacity = 1200
+  subnet_ids       = [aws_subnet.test1.id]
+}
+`, rName)
+}
+
+func testAccAwsFsxDataRepositoryTaskConfigBasic(rName string) string {
+	return testAccAwsFsxDataRepositoryTaskConfigBase(rName) + `
+resource "aws_fsx_data_repository_task" "test" {
+  file_system_id = aws_fsx_lustre_file_system.test.id
+
+  report {
+    enabled = false
+  }
+}
+`
+}
+
+func testAccAwsFsxDataRepositoryTaskConfigReport(rName string) string {
+	return testAccAwsFsxDataRepositoryTaskConfigBase(rName) + `
+resource "aws_fsx_data_repository_task" "test" {
+  file_system_id = aws_fsx_lustre_file_system.test.id
+  paths          = ["export"]
+
+  report {
+    enabled = true
+    format  = "REPORT_CSV_20191124"
+    path    = aws_fsx_lustre_file_system.test.export_path
+    scope   = "FAILED_FILES_ONLY"
+  }
+}
+`
+}
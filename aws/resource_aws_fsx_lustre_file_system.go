@@ -34,6 +34,11 @@ func resourceAwsFsxLustreFileSystem() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"backup_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"dns_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -88,7 +93,8 @@ func resourceAwsFsxLustreFileSystem() *schema.Resource {
 			},
 			"storage_capacity": {
 				Type:         schema.TypeInt,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.IntAtLeast(1200),
 			},
@@ -190,7 +196,6 @@ func resourceAwsFsxLustreFileSystemCreate(d *schema.ResourceData, meta interface
 	input := &fsx.CreateFileSystemInput{
 		ClientRequestToken: aws.String(resource.UniqueId()),
 		FileSystemType:     aws.String(fsx.FileSystemTypeLustre),
-		StorageCapacity:    aws.Int64(int64(d.Get("storage_capacity").(int))),
 		StorageType:        aws.String(d.Get("storage_type").(string)),
 		SubnetIds:          expandStringList(d.Get("subnet_ids").([]interface{})),
 		LustreConfiguration: &fsx.CreateFileSystemLustreConfiguration{
@@ -198,6 +203,10 @@ func resourceAwsFsxLustreFileSystemCreate(d *schema.ResourceData, meta interface
 		},
 	}
 
+	if v, ok := d.GetOk("storage_capacity"); ok {
+		input.StorageCapacity = aws.Int64(int64(v.(int)))
+	}
+
 	//Applicable only for TypePersistent1
 	if v, ok := d.GetOk("kms_key_id"); ok {
 		input.KmsKeyId = aws.String(v.(string))
@@ -251,12 +260,31 @@ func resourceAwsFsxLustreFileSystemCreate(d *schema.ResourceData, meta interface
 		input.LustreConfiguration.CopyTagsToBackups = aws.Bool(v.(bool))
 	}
 
-	result, err := conn.CreateFileSystem(input)
-	if err != nil {
-		return fmt.Errorf("Error creating FSx Lustre filesystem: %w", err)
-	}
+	if v, ok := d.GetOk("backup_id"); ok {
+		backupInput := &fsx.CreateFileSystemFromBackupInput{
+			BackupId:            aws.String(v.(string)),
+			ClientRequestToken:  input.ClientRequestToken,
+			LustreConfiguration: input.LustreConfiguration,
+			SecurityGroupIds:    input.SecurityGroupIds,
+			StorageType:         input.StorageType,
+			SubnetIds:           input.SubnetIds,
+			Tags:                input.Tags,
+		}
+
+		result, err := conn.CreateFileSystemFromBackup(backupInput)
+		if err != nil {
+			return fmt.Errorf("Error creating FSx Lustre filesystem from backup (%s): %w", v.(string), err)
+		}
 
-	d.SetId(aws.StringValue(result.FileSystem.FileSystemId))
+		d.SetId(aws.StringValue(result.FileSystem.FileSystemId))
+	} else {
+		result, err := conn.CreateFileSystem(input)
+		if err != nil {
+			return fmt.Errorf("Error creating FSx Lustre filesystem: %w", err)
+		}
+
+		d.SetId(aws.StringValue(result.FileSystem.FileSystemId))
+	}
 
 	log.Println("[DEBUG] Waiting for filesystem to become available")
 
@@ -51,6 +51,11 @@ func resourceAwsFsxWindowsFileSystem() *schema.Resource {
 				Default:      7,
 				ValidateFunc: validation.IntBetween(0, 90),
 			},
+			"backup_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"copy_tags_to_backups": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -146,7 +151,8 @@ func resourceAwsFsxWindowsFileSystem() *schema.Resource {
 			},
 			"storage_capacity": {
 				Type:         schema.TypeInt,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.IntBetween(32, 65536),
 			},
 			"subnet_ids": {
@@ -220,7 +226,6 @@ func resourceAwsFsxWindowsFileSystemCreate(d *schema.ResourceData, meta interfac
 	input := &fsx.CreateFileSystemInput{
 		ClientRequestToken: aws.String(resource.UniqueId()),
 		FileSystemType:     aws.String(fsx.FileSystemTypeWindows),
-		StorageCapacity:    aws.Int64(int64(d.Get("storage_capacity").(int))),
 		SubnetIds:          expandStringList(d.Get("subnet_ids").([]interface{})),
 		WindowsConfiguration: &fsx.CreateFileSystemWindowsConfiguration{
 			AutomaticBackupRetentionDays: aws.Int64(int64(d.Get("automatic_backup_retention_days").(int))),
@@ -229,6 +234,10 @@ func resourceAwsFsxWindowsFileSystemCreate(d *schema.ResourceData, meta interfac
 		},
 	}
 
+	if v, ok := d.GetOk("storage_capacity"); ok {
+		input.StorageCapacity = aws.Int64(int64(v.(int)))
+	}
+
 	if v, ok := d.GetOk("active_directory_id"); ok {
 		input.WindowsConfiguration.ActiveDirectoryId = aws.String(v.(string))
 	}
@@ -269,12 +278,31 @@ func resourceAwsFsxWindowsFileSystemCreate(d *schema.ResourceData, meta interfac
 		input.StorageType = aws.String(v.(string))
 	}
 
-	result, err := conn.CreateFileSystem(input)
-	if err != nil {
-		return fmt.Errorf("Error creating FSx filesystem: %s", err)
-	}
+	if v, ok := d.GetOk("backup_id"); ok {
+		backupInput := &fsx.CreateFileSystemFromBackupInput{
+			BackupId:             aws.String(v.(string)),
+			ClientRequestToken:   input.ClientRequestToken,
+			SecurityGroupIds:     input.SecurityGroupIds,
+			StorageType:          input.StorageType,
+			SubnetIds:            input.SubnetIds,
+			Tags:                 input.Tags,
+			WindowsConfiguration: input.WindowsConfiguration,
+		}
+
+		result, err := conn.CreateFileSystemFromBackup(backupInput)
+		if err != nil {
+			return fmt.Errorf("Error creating FSx filesystem from backup (%s): %s", v.(string), err)
+		}
 
-	d.SetId(aws.StringValue(result.FileSystem.FileSystemId))
+		d.SetId(aws.StringValue(result.FileSystem.FileSystemId))
+	} else {
+		result, err := conn.CreateFileSystem(input)
+		if err != nil {
+			return fmt.Errorf("Error creating FSx filesystem: %s", err)
+		}
+
+		d.SetId(aws.StringValue(result.FileSystem.FileSystemId))
+	}
 
 	log.Println("[DEBUG] Waiting for filesystem to become available")
 
@@ -18,7 +18,7 @@ func resourceAwsIamGroup() *schema.Resource {
 		Update: resourceAwsIamGroupUpdate,
 		Delete: resourceAwsIamGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByARN("iam", arnResourcePathNameFunc("group"), schema.ImportStatePassthrough),
 		},
 
 		Schema: map[string]*schema.Schema{
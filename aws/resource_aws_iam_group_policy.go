@@ -29,7 +29,7 @@ func resourceAwsIamGroupPolicy() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     validateIAMPolicyJson,
+				ValidateFunc:     validateIAMIdentityPolicyDocument,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 			"name": {
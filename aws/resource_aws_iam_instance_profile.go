@@ -20,7 +20,7 @@ func resourceAwsIamInstanceProfile() *schema.Resource {
 		Update: resourceAwsIamInstanceProfileUpdate,
 		Delete: resourceAwsIamInstanceProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByARN("iam", arnResourcePathNameFunc("instance-profile"), schema.ImportStatePassthrough),
 		},
 
 		Schema: map[string]*schema.Schema{
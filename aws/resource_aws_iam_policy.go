@@ -39,7 +39,7 @@ func resourceAwsIamPolicy() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     validateIAMPolicyJson,
+				ValidateFunc:     validateIAMIdentityPolicyDocument,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 			"name": {
@@ -24,7 +24,7 @@ func resourceAwsIamRole() *schema.Resource {
 		Update: resourceAwsIamRoleUpdate,
 		Delete: resourceAwsIamRoleDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceAwsIamRoleImport,
+			State: importStateByARN("iam", arnResourcePathNameFunc("role"), resourceAwsIamRoleImport),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -88,7 +88,7 @@ func resourceAwsIamRole() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
-				ValidateFunc:     validation.StringIsJSON,
+				ValidateFunc:     validateIAMResourcePolicyDocument,
 			},
 
 			"force_detach_policies": {
@@ -165,6 +165,12 @@ func TestAccAWSIAMRole_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccARNImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
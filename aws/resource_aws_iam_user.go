@@ -21,7 +21,7 @@ func resourceAwsIamUser() *schema.Resource {
 		Update: resourceAwsIamUserUpdate,
 		Delete: resourceAwsIamUserDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByARN("iam", arnResourcePathNameFunc("user"), schema.ImportStatePassthrough),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -34,7 +34,7 @@ func resourceAwsInstance() *schema.Resource {
 		Update: resourceAwsInstanceUpdate,
 		Delete: resourceAwsInstanceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByARN("ec2", arnResourceIDFunc("instance", "/"), schema.ImportStatePassthrough),
 		},
 
 		SchemaVersion: 1,
@@ -21,7 +21,7 @@ func resourceAwsInternetGateway() *schema.Resource {
 		Update: resourceAwsInternetGatewayUpdate,
 		Delete: resourceAwsInternetGatewayDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByARN("ec2", arnResourceIDFunc("internet-gateway", "/"), schema.ImportStatePassthrough),
 		},
 
 		Schema: map[string]*schema.Schema{
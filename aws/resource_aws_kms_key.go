@@ -25,7 +25,7 @@ func resourceAwsKmsKey() *schema.Resource {
 		Delete: resourceAwsKmsKeyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByARN("kms", arnResourceIDFunc("key", "/"), schema.ImportStatePassthrough),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -72,7 +72,7 @@ func resourceAwsKmsKey() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ValidateFunc:     validation.StringIsJSON,
+				ValidateFunc:     validateIAMResourcePolicyDocument,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 			"is_enabled": {
@@ -39,10 +39,10 @@ func resourceAwsLambdaFunction() *schema.Resource {
 		},
 
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			State: importStateByARN("lambda", arnResourceIDFunc("function", ":"), func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				d.Set("function_name", d.Id())
 				return []*schema.ResourceData{d}, nil
-			},
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
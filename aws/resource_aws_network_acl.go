@@ -26,9 +26,11 @@ func resourceAwsNetworkAcl() *schema.Resource {
 		Delete: resourceAwsNetworkAclDelete,
 		Update: resourceAwsNetworkAclUpdate,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByARN("ec2", arnResourceIDFunc("network-acl", "/"), schema.ImportStatePassthrough),
 		},
 
+		CustomizeDiff: networkAclManagedRuleNumberRangeCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -175,7 +177,8 @@ func resourceAwsNetworkAcl() *schema.Resource {
 				},
 				Set: resourceAwsNetworkAclEntryHash,
 			},
-			"tags": tagsSchema(),
+			"managed_rule_number_range": networkAclManagedRuleNumberRangeSchema(),
+			"tags":                      tagsSchema(),
 			"owner_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -232,6 +235,8 @@ func resourceAwsNetworkAclRead(d *schema.ResourceData, meta interface{}) error {
 	var ingressEntries []*ec2.NetworkAclEntry
 	var egressEntries []*ec2.NetworkAclEntry
 
+	ruleNumbers := expandNetworkAclRuleNumberRange(d.Get("managed_rule_number_range").([]interface{}))
+
 	// separate the ingress and egress rules
 	for _, e := range networkAcl.Entries {
 		// Skip the default rules added by AWS. They can be neither
@@ -241,6 +246,12 @@ func resourceAwsNetworkAclRead(d *schema.ResourceData, meta interface{}) error {
 			continue
 		}
 
+		// Skip rules outside of the managed rule number range, they are
+		// left to aws_network_acl_rule resources.
+		if !ruleNumbers.contains(aws.Int64Value(e.RuleNumber)) {
+			continue
+		}
+
 		if aws.BoolValue(e.Egress) {
 			egressEntries = append(egressEntries, e)
 		} else {
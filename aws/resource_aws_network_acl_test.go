@@ -721,6 +721,51 @@ func TestAccAWSNetworkAcl_espProtocol(t *testing.T) {
 	})
 }
 
+func TestAccAWSNetworkAcl_ManagedRuleNumberRange(t *testing.T) {
+	resourceName := "aws_network_acl.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	var networkAcl ec2.NetworkAcl
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkAclDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkAclConfigManagedRuleNumberRange(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSNetworkAclExists(resourceName, &networkAcl),
+					resource.TestCheckResourceAttr(resourceName, "managed_rule_number_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_rule_number_range.0.from", "100"),
+					resource.TestCheckResourceAttr(resourceName, "managed_rule_number_range.0.to", "199"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ingress.*", map[string]string{
+						"rule_no": "100",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "0"),
+					resource.TestCheckResourceAttr("aws_network_acl_rule.test", "rule_number", "200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkAcl_ManagedRuleNumberRange_OutOfRange(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkAclDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSNetworkAclConfigManagedRuleNumberRangeOutOfRange(rName),
+				ExpectError: regexp.MustCompile(`ingress rule number \(200\) is outside of managed_rule_number_range \(100-199\)`),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSNetworkAclDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
@@ -1639,3 +1684,84 @@ resource "aws_network_acl" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccAWSNetworkAclConfigManagedRuleNumberRange(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_acl" "test" {
+  vpc_id = aws_vpc.test.id
+
+  managed_rule_number_range {
+    from = 100
+    to   = 199
+  }
+
+  ingress {
+    protocol   = "tcp"
+    rule_no    = 100
+    action     = "allow"
+    cidr_block = "10.0.0.0/8"
+    from_port  = 443
+    to_port    = 443
+  }
+
+  egress = []
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_acl_rule" "test" {
+  network_acl_id = aws_network_acl.test.id
+  rule_number    = 200
+  egress         = false
+  protocol       = "tcp"
+  rule_action    = "allow"
+  cidr_block     = "10.0.0.0/8"
+  from_port      = 22
+  to_port        = 22
+}
+`, rName)
+}
+
+func testAccAWSNetworkAclConfigManagedRuleNumberRangeOutOfRange(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_acl" "test" {
+  vpc_id = aws_vpc.test.id
+
+  managed_rule_number_range {
+    from = 100
+    to   = 199
+  }
+
+  ingress {
+    protocol   = "tcp"
+    rule_no    = 200
+    action     = "allow"
+    cidr_block = "10.0.0.0/8"
+    from_port  = 443
+    to_port    = 443
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
@@ -0,0 +1,186 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsOrganizationsDelegatedAdministrator() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsOrganizationsDelegatedAdministratorCreate,
+		Read:   resourceAwsOrganizationsDelegatedAdministratorRead,
+		Delete: resourceAwsOrganizationsDelegatedAdministratorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delegation_enabled_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"joined_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"joined_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_principal": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsOrganizationsDelegatedAdministratorCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	accountID := d.Get("account_id").(string)
+	servicePrincipal := d.Get("service_principal").(string)
+
+	input := &organizations.RegisterDelegatedAdministratorInput{
+		AccountId:        aws.String(accountID),
+		ServicePrincipal: aws.String(servicePrincipal),
+	}
+
+	log.Printf("[DEBUG] Registering Organizations Delegated Administrator: %s", input)
+	_, err := conn.RegisterDelegatedAdministrator(input)
+
+	if err != nil {
+		return fmt.Errorf("error registering Organizations Delegated Administrator (%s) for service (%s): %w", accountID, servicePrincipal, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", accountID, servicePrincipal))
+
+	return resourceAwsOrganizationsDelegatedAdministratorRead(d, meta)
+}
+
+func resourceAwsOrganizationsDelegatedAdministratorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	accountID, servicePrincipal, err := decodeAwsOrganizationsDelegatedAdministratorID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &organizations.ListDelegatedAdministratorsInput{
+		ServicePrincipal: aws.String(servicePrincipal),
+	}
+
+	var delegatedAccount *organizations.DelegatedAdministrator
+
+	err = conn.ListDelegatedAdministratorsPages(input, func(page *organizations.ListDelegatedAdministratorsOutput, lastPage bool) bool {
+		for _, delegated := range page.DelegatedAdministrators {
+			if aws.StringValue(delegated.Id) == accountID {
+				delegatedAccount = delegated
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, organizations.ErrCodeAWSOrganizationsNotInUseException) {
+		log.Printf("[WARN] Organizations Delegated Administrator (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Organizations Delegated Administrators for service (%s): %w", servicePrincipal, err)
+	}
+
+	if delegatedAccount == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Organizations Delegated Administrator (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Organizations Delegated Administrator (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("arn", delegatedAccount.Arn)
+	d.Set("delegation_enabled_date", aws.TimeValue(delegatedAccount.DelegationEnabledDate).Format(time.RFC3339))
+	d.Set("email", delegatedAccount.Email)
+	d.Set("joined_method", delegatedAccount.JoinedMethod)
+	d.Set("joined_timestamp", aws.TimeValue(delegatedAccount.JoinedTimestamp).Format(time.RFC3339))
+	d.Set("name", delegatedAccount.Name)
+	d.Set("service_principal", servicePrincipal)
+	d.Set("status", delegatedAccount.Status)
+
+	return nil
+}
+
+func resourceAwsOrganizationsDelegatedAdministratorDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	accountID, servicePrincipal, err := decodeAwsOrganizationsDelegatedAdministratorID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &organizations.DeregisterDelegatedAdministratorInput{
+		AccountId:        aws.String(accountID),
+		ServicePrincipal: aws.String(servicePrincipal),
+	}
+
+	log.Printf("[DEBUG] Deregistering Organizations Delegated Administrator: %s", d.Id())
+	_, err = conn.DeregisterDelegatedAdministrator(input)
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAccountNotRegisteredException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deregistering Organizations Delegated Administrator (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeAwsOrganizationsDelegatedAdministratorID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("expected ID in format of ACCOUNTID/SERVICEPRINCIPAL, received: %s", id)
+	}
+	return idParts[0], idParts[1], nil
+}
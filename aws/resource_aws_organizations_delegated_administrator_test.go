@@ -0,0 +1,181 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccAwsOrganizationsDelegatedAdministrator_basic(t *testing.T) {
+	var providers []*schema.Provider
+	var organization organizations.DelegatedAdministrator
+	resourceName := "aws_organizations_delegated_administrator.test"
+	servicePrincipal := "config-multiaccountsetup.amazonaws.com"
+	dataSourceIdentity := "data.aws_caller_identity.delegated"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+			testAccOrganizationsAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsOrganizationsDelegatedAdministratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsOrganizationsDelegatedAdministratorConfig(servicePrincipal),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsDelegatedAdministratorExists(resourceName, &organization),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", dataSourceIdentity, "account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					testAccCheckResourceAttrRfc3339(resourceName, "delegation_enabled_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "email"),
+					resource.TestCheckResourceAttrSet(resourceName, "joined_method"),
+					testAccCheckResourceAttrRfc3339(resourceName, "joined_timestamp"),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "service_principal", servicePrincipal),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsOrganizationsDelegatedAdministrator_disappears(t *testing.T) {
+	var providers []*schema.Provider
+	var organization organizations.DelegatedAdministrator
+	resourceName := "aws_organizations_delegated_administrator.test"
+	servicePrincipal := "config-multiaccountsetup.amazonaws.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+			testAccOrganizationsAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsOrganizationsDelegatedAdministratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsOrganizationsDelegatedAdministratorConfig(servicePrincipal),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsDelegatedAdministratorExists(resourceName, &organization),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsOrganizationsDelegatedAdministrator(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsOrganizationsDelegatedAdministratorDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_organizations_delegated_administrator" {
+			continue
+		}
+
+		accountID, servicePrincipal, err := decodeAwsOrganizationsDelegatedAdministratorID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		input := &organizations.ListDelegatedAdministratorsInput{
+			ServicePrincipal: aws.String(servicePrincipal),
+		}
+
+		exists := false
+		err = conn.ListDelegatedAdministratorsPages(input, func(page *organizations.ListDelegatedAdministratorsOutput, lastPage bool) bool {
+			for _, delegated := range page.DelegatedAdministrators {
+				if aws.StringValue(delegated.Id) == accountID {
+					exists = true
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Organizations Delegated Administrator (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsOrganizationsDelegatedAdministratorExists(n string, org *organizations.DelegatedAdministrator) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Organizations Delegated Administrator ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+
+		accountID, servicePrincipal, err := decodeAwsOrganizationsDelegatedAdministratorID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		input := &organizations.ListDelegatedAdministratorsInput{
+			ServicePrincipal: aws.String(servicePrincipal),
+		}
+
+		var delegatedAccount *organizations.DelegatedAdministrator
+		err = conn.ListDelegatedAdministratorsPages(input, func(page *organizations.ListDelegatedAdministratorsOutput, lastPage bool) bool {
+			for _, delegated := range page.DelegatedAdministrators {
+				if aws.StringValue(delegated.Id) == accountID {
+					delegatedAccount = delegated
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if delegatedAccount == nil {
+			return fmt.Errorf("Organizations Delegated Administrator (%s) not found", rs.Primary.ID)
+		}
+
+		*org = *delegatedAccount
+
+		return nil
+	}
+}
+
+func testAccAwsOrganizationsDelegatedAdministratorConfig(servicePrincipal string) string {
+	return composeConfig(testAccAlternateAccountProviderConfig(), fmt.Sprintf(`
+data "aws_caller_identity" "delegated" {
+  provider = "awsalternate"
+}
+
+resource "aws_organizations_delegated_administrator" "test" {
+  account_id        = data.aws_caller_identity.delegated.account_id
+  service_principal = %[1]q
+}
+`, servicePrincipal))
+}
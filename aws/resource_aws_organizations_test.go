@@ -20,6 +20,13 @@ func TestAccAWSOrganizations_serial(t *testing.T) {
 			"ParentId": testAccAwsOrganizationsAccount_ParentId,
 			"Tags":     testAccAwsOrganizationsAccount_Tags,
 		},
+		"DelegatedAdministrator": {
+			"basic":      testAccAwsOrganizationsDelegatedAdministrator_basic,
+			"disappears": testAccAwsOrganizationsDelegatedAdministrator_disappears,
+		},
+		"DelegatedServices": {
+			"DataSource": testAccDataSourceAwsOrganizationsDelegatedServices_basic,
+		},
 		"OrganizationalUnit": {
 			"basic": testAccAwsOrganizationsOrganizationalUnit_basic,
 			"Name":  testAccAwsOrganizationsOrganizationalUnit_Name,
@@ -40,7 +40,7 @@ func resourceAwsRouteTable() *schema.Resource {
 		Update: resourceAwsRouteTableUpdate,
 		Delete: resourceAwsRouteTableDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByARN("ec2", arnResourceIDFunc("route-table", "/"), schema.ImportStatePassthrough),
 		},
 
 		Schema: map[string]*schema.Schema{
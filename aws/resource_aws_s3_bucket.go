@@ -48,7 +48,7 @@ func resourceAwsS3Bucket() *schema.Resource {
 		Update: resourceAwsS3BucketUpdate,
 		Delete: resourceAwsS3BucketDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByARN("s3", resourceAwsS3BucketIDFromARN, schema.ImportStatePassthrough),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -2763,3 +2763,13 @@ func flattenGrants(ap *s3.GetBucketAclOutput) []interface{} {
 
 	return grants
 }
+
+// resourceAwsS3BucketIDFromARN returns the bucket name from a bucket ARN,
+// e.g. arn:aws:s3:::my-bucket. Object ARNs are rejected.
+func resourceAwsS3BucketIDFromARN(v arn.ARN) (string, error) {
+	if v.Resource == "" || strings.Contains(v.Resource, "/") {
+		return "", fmt.Errorf("expected resource of the form <bucket>, got %q", v.Resource)
+	}
+
+	return v.Resource, nil
+}
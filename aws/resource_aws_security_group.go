@@ -2,6 +2,7 @@ package aws
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"sort"
@@ -29,7 +30,7 @@ func resourceAwsSecurityGroup() *schema.Resource {
 		Update: resourceAwsSecurityGroupUpdate,
 		Delete: resourceAwsSecurityGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByARN("ec2", arnResourceIDFunc("security-group", "/"), schema.ImportStatePassthrough),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -37,6 +38,8 @@ func resourceAwsSecurityGroup() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: resourceAwsSecurityGroupCustomizeDiff,
+
 		SchemaVersion: 1,
 		MigrateState:  resourceAwsSecurityGroupMigrateState,
 
@@ -234,6 +237,31 @@ func resourceAwsSecurityGroup() *schema.Resource {
 	}
 }
 
+// resourceAwsSecurityGroupCustomizeDiff records whether the inline rules of an
+// existing security group are changing and errors if standalone rule resources
+// also manage rules of the same type, as each apply would then undo the other.
+func resourceAwsSecurityGroupCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	for _, ruleType := range []string{"ingress", "egress"} {
+		changing := diff.HasChange(ruleType)
+
+		awsSecurityGroupRuleRegistry.SetInline(diff.Id(), ruleType, changing)
+
+		if !changing {
+			continue
+		}
+
+		if ruleIDs := awsSecurityGroupRuleRegistry.Standalone(diff.Id(), ruleType); len(ruleIDs) > 0 {
+			return fmt.Errorf("Security Group (%s) %s rules are managed both inline and by standalone rule resources (%s); use only one of them", diff.Id(), ruleType, strings.Join(ruleIDs, ", "))
+		}
+	}
+
+	return nil
+}
+
 func resourceAwsSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSSecurityGroupEgressRule_basic(t *testing.T) {
+	var group ec2.SecurityGroup
+	resourceName := "aws_security_group_egress_rule.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupEgressRuleConfigCidrIpv6(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupRuleExists("aws_security_group.test", &group),
+					// The default allow all egress rule remains in place.
+					testAccCheckAWSSecurityGroupDirectionalRuleCount(&group, "egress", 2),
+					resource.TestCheckResourceAttr(resourceName, "cidr_ipv6", "::/0"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "from_port", "443"),
+					resource.TestCheckResourceAttr(resourceName, "ip_protocol", "tcp"),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", "aws_security_group.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "to_port", "443"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSSecurityGroupEgressRuleConfigCidrIpv6(rName string) string {
+	return composeConfig(
+		testAccAWSSecurityGroupDirectionalRuleConfigBase(rName),
+		`
+resource "aws_security_group_egress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv6   = "::/0"
+  from_port   = 443
+  ip_protocol = "tcp"
+  to_port     = 443
+}
+`)
+}
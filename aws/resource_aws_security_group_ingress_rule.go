@@ -0,0 +1,444 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+)
+
+func resourceAwsSecurityGroupIngressRule() *schema.Resource {
+	return resourceAwsSecurityGroupDirectionalRule("ingress")
+}
+
+// resourceAwsSecurityGroupDirectionalRule returns the schema shared by the
+// aws_security_group_ingress_rule and aws_security_group_egress_rule resources,
+// each of which manages exactly one rule of the given type.
+func resourceAwsSecurityGroupDirectionalRule(ruleType string) *schema.Resource {
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceAwsSecurityGroupDirectionalRuleCreate(d, meta, ruleType)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceAwsSecurityGroupDirectionalRuleRead(d, meta, ruleType)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceAwsSecurityGroupDirectionalRuleUpdate(d, meta, ruleType)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceAwsSecurityGroupDirectionalRuleDelete(d, meta, ruleType)
+		},
+
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return validateSecurityGroupStandaloneRule(diff.Get("security_group_id").(string), ruleType)
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cidr_ipv4": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIpv4CIDRNetworkAddress,
+				ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+			},
+			"cidr_ipv6": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIpv6CIDRNetworkAddress,
+				ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateSecurityGroupRuleDescription,
+			},
+			"from_port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ip_protocol": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: protocolStateFunc,
+			},
+			"prefix_list_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+			},
+			"referenced_security_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"to_port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSecurityGroupDirectionalRuleCreate(d *schema.ResourceData, meta interface{}, ruleType string) error {
+	conn := meta.(*AWSClient).ec2conn
+	groupID := d.Get("security_group_id").(string)
+
+	awsMutexKV.Lock(groupID)
+	defer awsMutexKV.Unlock(groupID)
+
+	sg, err := findResourceSecurityGroup(conn, groupID)
+
+	if err != nil {
+		return fmt.Errorf("error reading Security Group (%s): %w", groupID, err)
+	}
+
+	perm := expandSecurityGroupDirectionalRuleIpPermission(d, sg)
+
+	log.Printf("[DEBUG] Authorizing Security Group (%s) %s rule: %s", groupID, ruleType, perm)
+	switch ruleType {
+	case "ingress":
+		input := &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       sg.GroupId,
+			IpPermissions: []*ec2.IpPermission{perm},
+		}
+
+		if aws.StringValue(sg.VpcId) == "" {
+			input.GroupId = nil
+			input.GroupName = sg.GroupName
+		}
+
+		_, err = conn.AuthorizeSecurityGroupIngress(input)
+	default:
+		_, err = conn.AuthorizeSecurityGroupEgress(&ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       sg.GroupId,
+			IpPermissions: []*ec2.IpPermission{perm},
+		})
+	}
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidPermissionDuplicate) {
+		return fmt.Errorf("error authorizing Security Group (%s) %s rule: the rule already exists, it may be managed by another resource: %w", groupID, ruleType, err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error authorizing Security Group (%s) %s rule: %w", groupID, ruleType, err)
+	}
+
+	id := ipPermissionIDHash(groupID, ruleType, perm)
+
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		rule, err := findSecurityGroupDirectionalRule(conn, groupID, ruleType, perm)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if rule == nil {
+			return resource.RetryableError(fmt.Errorf("Security Group (%s) %s rule (%s) not found", groupID, ruleType, id))
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		var rule *ec2.IpPermission
+
+		rule, err = findSecurityGroupDirectionalRule(conn, groupID, ruleType, perm)
+
+		if err == nil && rule == nil {
+			err = fmt.Errorf("Security Group (%s) %s rule (%s) not found", groupID, ruleType, id)
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("error waiting for Security Group (%s) %s rule (%s) creation: %w", groupID, ruleType, id, err)
+	}
+
+	d.SetId(id)
+	awsSecurityGroupRuleRegistry.AddStandalone(groupID, ruleType, id)
+
+	return resourceAwsSecurityGroupDirectionalRuleRead(d, meta, ruleType)
+}
+
+func resourceAwsSecurityGroupDirectionalRuleRead(d *schema.ResourceData, meta interface{}, ruleType string) error {
+	conn := meta.(*AWSClient).ec2conn
+	groupID := d.Get("security_group_id").(string)
+
+	sg, err := findResourceSecurityGroup(conn, groupID)
+
+	if _, ok := err.(securityGroupNotFound); ok && !d.IsNewResource() {
+		log.Printf("[WARN] Security Group (%s) not found, removing %s rule (%s) from state", groupID, ruleType, d.Id())
+		awsSecurityGroupRuleRegistry.RemoveStandalone(groupID, ruleType, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Security Group (%s): %w", groupID, err)
+	}
+
+	perm := expandSecurityGroupDirectionalRuleIpPermission(d, sg)
+	rule := findRuleMatch(perm, securityGroupIpPermissions(sg, ruleType), aws.StringValue(sg.VpcId) != "")
+
+	if rule == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Security Group (%s) %s rule (%s): not found after creation", groupID, ruleType, d.Id())
+		}
+
+		log.Printf("[WARN] Security Group (%s) %s rule (%s) not found, removing from state", groupID, ruleType, d.Id())
+		awsSecurityGroupRuleRegistry.RemoveStandalone(groupID, ruleType, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	awsSecurityGroupRuleRegistry.AddStandalone(groupID, ruleType, d.Id())
+
+	d.Set("description", securityGroupDirectionalRuleDescription(perm, rule))
+	d.Set("ip_protocol", rule.IpProtocol)
+
+	if aws.StringValue(rule.IpProtocol) != "-1" {
+		d.Set("from_port", rule.FromPort)
+		d.Set("to_port", rule.ToPort)
+	}
+
+	return nil
+}
+
+func resourceAwsSecurityGroupDirectionalRuleUpdate(d *schema.ResourceData, meta interface{}, ruleType string) error {
+	conn := meta.(*AWSClient).ec2conn
+	groupID := d.Get("security_group_id").(string)
+
+	if d.HasChange("description") {
+		awsMutexKV.Lock(groupID)
+		defer awsMutexKV.Unlock(groupID)
+
+		sg, err := findResourceSecurityGroup(conn, groupID)
+
+		if err != nil {
+			return fmt.Errorf("error reading Security Group (%s): %w", groupID, err)
+		}
+
+		perm := expandSecurityGroupDirectionalRuleIpPermission(d, sg)
+
+		switch ruleType {
+		case "ingress":
+			input := &ec2.UpdateSecurityGroupRuleDescriptionsIngressInput{
+				GroupId:       sg.GroupId,
+				IpPermissions: []*ec2.IpPermission{perm},
+			}
+
+			if aws.StringValue(sg.VpcId) == "" {
+				input.GroupId = nil
+				input.GroupName = sg.GroupName
+			}
+
+			_, err = conn.UpdateSecurityGroupRuleDescriptionsIngress(input)
+		default:
+			_, err = conn.UpdateSecurityGroupRuleDescriptionsEgress(&ec2.UpdateSecurityGroupRuleDescriptionsEgressInput{
+				GroupId:       sg.GroupId,
+				IpPermissions: []*ec2.IpPermission{perm},
+			})
+		}
+
+		if err != nil {
+			return fmt.Errorf("error updating Security Group (%s) %s rule (%s) description: %w", groupID, ruleType, d.Id(), err)
+		}
+	}
+
+	return resourceAwsSecurityGroupDirectionalRuleRead(d, meta, ruleType)
+}
+
+func resourceAwsSecurityGroupDirectionalRuleDelete(d *schema.ResourceData, meta interface{}, ruleType string) error {
+	conn := meta.(*AWSClient).ec2conn
+	groupID := d.Get("security_group_id").(string)
+
+	awsMutexKV.Lock(groupID)
+	defer awsMutexKV.Unlock(groupID)
+
+	sg, err := findResourceSecurityGroup(conn, groupID)
+
+	if _, ok := err.(securityGroupNotFound); ok {
+		awsSecurityGroupRuleRegistry.RemoveStandalone(groupID, ruleType, d.Id())
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Security Group (%s): %w", groupID, err)
+	}
+
+	perm := expandSecurityGroupDirectionalRuleIpPermission(d, sg)
+
+	log.Printf("[DEBUG] Revoking Security Group (%s) %s rule: %s", groupID, ruleType, perm)
+	switch ruleType {
+	case "ingress":
+		input := &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:       sg.GroupId,
+			IpPermissions: []*ec2.IpPermission{perm},
+		}
+
+		if aws.StringValue(sg.VpcId) == "" {
+			input.GroupId = nil
+			input.GroupName = sg.GroupName
+		}
+
+		_, err = conn.RevokeSecurityGroupIngress(input)
+	default:
+		_, err = conn.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+			GroupId:       sg.GroupId,
+			IpPermissions: []*ec2.IpPermission{perm},
+		})
+	}
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidPermissionNotFound) {
+		err = nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error revoking Security Group (%s) %s rule (%s): %w", groupID, ruleType, d.Id(), err)
+	}
+
+	awsSecurityGroupRuleRegistry.RemoveStandalone(groupID, ruleType, d.Id())
+
+	return nil
+}
+
+// expandSecurityGroupDirectionalRuleIpPermission returns the single rule
+// described by the resource as an IpPermission of the given security group.
+func expandSecurityGroupDirectionalRuleIpPermission(d *schema.ResourceData, sg *ec2.SecurityGroup) *ec2.IpPermission {
+	protocol := protocolForValue(d.Get("ip_protocol").(string))
+
+	perm := &ec2.IpPermission{
+		IpProtocol: aws.String(protocol),
+	}
+
+	// InvalidParameterValue: When protocol is ALL, you cannot specify from-port.
+	if protocol != "-1" {
+		perm.FromPort = aws.Int64(int64(d.Get("from_port").(int)))
+		perm.ToPort = aws.Int64(int64(d.Get("to_port").(int)))
+	}
+
+	var description *string
+	if v, ok := d.GetOk("description"); ok {
+		description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv4"); ok {
+		perm.IpRanges = []*ec2.IpRange{{
+			CidrIp:      aws.String(v.(string)),
+			Description: description,
+		}}
+	}
+
+	if v, ok := d.GetOk("cidr_ipv6"); ok {
+		perm.Ipv6Ranges = []*ec2.Ipv6Range{{
+			CidrIpv6:    aws.String(v.(string)),
+			Description: description,
+		}}
+	}
+
+	if v, ok := d.GetOk("prefix_list_id"); ok {
+		perm.PrefixListIds = []*ec2.PrefixListId{{
+			PrefixListId: aws.String(v.(string)),
+			Description:  description,
+		}}
+	}
+
+	if v, ok := d.GetOk("referenced_security_group_id"); ok {
+		pair := &ec2.UserIdGroupPair{
+			Description: description,
+		}
+
+		ownerID, groupID := "", v.(string)
+		if parts := strings.Split(groupID, "/"); len(parts) == 2 {
+			ownerID, groupID = parts[0], parts[1]
+		}
+
+		if aws.StringValue(sg.VpcId) == "" {
+			pair.GroupName = aws.String(groupID)
+		} else {
+			pair.GroupId = aws.String(groupID)
+
+			if ownerID != "" {
+				pair.UserId = aws.String(ownerID)
+			}
+		}
+
+		perm.UserIdGroupPairs = []*ec2.UserIdGroupPair{pair}
+	}
+
+	return perm
+}
+
+// securityGroupDirectionalRuleDescription returns the description of the
+// element of the matching rule that corresponds to the single rule described by perm.
+func securityGroupDirectionalRuleDescription(perm, rule *ec2.IpPermission) string {
+	for _, v := range perm.IpRanges {
+		for _, r := range rule.IpRanges {
+			if aws.StringValue(v.CidrIp) == aws.StringValue(r.CidrIp) {
+				return aws.StringValue(r.Description)
+			}
+		}
+	}
+
+	for _, v := range perm.Ipv6Ranges {
+		for _, r := range rule.Ipv6Ranges {
+			if aws.StringValue(v.CidrIpv6) == aws.StringValue(r.CidrIpv6) {
+				return aws.StringValue(r.Description)
+			}
+		}
+	}
+
+	for _, v := range perm.PrefixListIds {
+		for _, r := range rule.PrefixListIds {
+			if aws.StringValue(v.PrefixListId) == aws.StringValue(r.PrefixListId) {
+				return aws.StringValue(r.Description)
+			}
+		}
+	}
+
+	for _, v := range perm.UserIdGroupPairs {
+		for _, r := range rule.UserIdGroupPairs {
+			if aws.StringValue(v.GroupId) == aws.StringValue(r.GroupId) && aws.StringValue(v.GroupName) == aws.StringValue(r.GroupName) {
+				return aws.StringValue(r.Description)
+			}
+		}
+	}
+
+	return ""
+}
+
+func findSecurityGroupDirectionalRule(conn *ec2.EC2, groupID, ruleType string, perm *ec2.IpPermission) (*ec2.IpPermission, error) {
+	sg, err := findResourceSecurityGroup(conn, groupID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return findRuleMatch(perm, securityGroupIpPermissions(sg, ruleType), aws.StringValue(sg.VpcId) != ""), nil
+}
+
+func securityGroupIpPermissions(sg *ec2.SecurityGroup, ruleType string) []*ec2.IpPermission {
+	if ruleType == "ingress" {
+		return sg.IpPermissions
+	}
+
+	return sg.IpPermissionsEgress
+}
@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSSecurityGroupIngressRule_basic(t *testing.T) {
+	var group ec2.SecurityGroup
+	resourceName := "aws_security_group_ingress_rule.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupIngressRuleConfigCidrIpv4(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupRuleExists("aws_security_group.test", &group),
+					testAccCheckAWSSecurityGroupDirectionalRuleCount(&group, "ingress", 1),
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^sgrule-\d+$`)),
+					resource.TestCheckResourceAttr(resourceName, "cidr_ipv4", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "from_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "ip_protocol", "tcp"),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", "aws_security_group.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "to_port", "8080"),
+				),
+			},
+			{
+				Config: testAccAWSSecurityGroupIngressRuleConfigCidrIpv4(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupRuleExists("aws_security_group.test", &group),
+					testAccCheckAWSSecurityGroupDirectionalRuleCount(&group, "ingress", 1),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSecurityGroupIngressRule_ReferencedSecurityGroupId(t *testing.T) {
+	var group ec2.SecurityGroup
+	resourceName := "aws_security_group_ingress_rule.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupIngressRuleConfigReferencedSecurityGroupId(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupRuleExists("aws_security_group.test", &group),
+					testAccCheckAWSSecurityGroupDirectionalRuleCount(&group, "ingress", 1),
+					resource.TestCheckResourceAttr(resourceName, "ip_protocol", "-1"),
+					resource.TestCheckResourceAttrPair(resourceName, "referenced_security_group_id", "aws_security_group.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSecurityGroupIngressRule_InlineConflict(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSSecurityGroupIngressRuleConfigInlineConflict(rName),
+				ExpectError: regexp.MustCompile(`managed both inline and by standalone rule resources`),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSecurityGroupDirectionalRuleCount(group *ec2.SecurityGroup, ruleType string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		perms := securityGroupIpPermissions(group, ruleType)
+
+		if got := len(perms); got != expected {
+			return fmt.Errorf("Security Group (%s) has %d %s rules, expected %d", aws.StringValue(group.GroupId), got, ruleType, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSSecurityGroupDirectionalRuleConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccAWSSecurityGroupIngressRuleConfigCidrIpv4(rName, description string) string {
+	return composeConfig(
+		testAccAWSSecurityGroupDirectionalRuleConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  description = %[1]q
+  from_port   = 80
+  ip_protocol = "tcp"
+  to_port     = 8080
+}
+`, description))
+}
+
+func testAccAWSSecurityGroupIngressRuleConfigReferencedSecurityGroupId(rName string) string {
+	return composeConfig(
+		testAccAWSSecurityGroupDirectionalRuleConfigBase(rName),
+		`
+resource "aws_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  referenced_security_group_id = aws_security_group.test.id
+  ip_protocol                  = "-1"
+}
+`)
+}
+
+func testAccAWSSecurityGroupIngressRuleConfigInlineConflict(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  ingress {
+    protocol    = "tcp"
+    from_port   = 22
+    to_port     = 22
+    cidr_blocks = ["10.0.0.0/8"]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 443
+  ip_protocol = "tcp"
+  to_port     = 443
+}
+`, rName)
+}
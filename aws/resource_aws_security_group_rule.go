@@ -2,6 +2,7 @@ package aws
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"sort"
@@ -38,6 +39,8 @@ func resourceAwsSecurityGroupRule() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: resourceAwsSecurityGroupRuleCustomizeDiff,
+
 		SchemaVersion: 2,
 		MigrateState:  resourceAwsSecurityGroupRuleMigrateState,
 
@@ -274,6 +277,8 @@ information and instructions for recovery. Error message: %s`, sg_id, awsErr.Mes
 	}
 
 	d.SetId(id)
+	awsSecurityGroupRuleRegistry.AddStandalone(sg_id, ruleType, id)
+
 	return nil
 }
 
@@ -338,6 +343,8 @@ func resourceAwsSecurityGroupRuleRead(d *schema.ResourceData, meta interface{})
 		d.SetId(id)
 	}
 
+	awsSecurityGroupRuleRegistry.AddStandalone(sg_id, ruleType, d.Id())
+
 	return nil
 }
 
@@ -404,6 +411,26 @@ func resourceAwsSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}
 		}
 	}
 
+	awsSecurityGroupRuleRegistry.RemoveStandalone(sg_id, ruleType, d.Id())
+
+	return nil
+}
+
+func resourceAwsSecurityGroupRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return validateSecurityGroupStandaloneRule(diff.Get("security_group_id").(string), diff.Get("type").(string))
+}
+
+// validateSecurityGroupStandaloneRule errors if the inline rules of the given
+// type are changing in the security group a standalone rule resource belongs to.
+func validateSecurityGroupStandaloneRule(groupID, ruleType string) error {
+	if groupID == "" {
+		return nil
+	}
+
+	if awsSecurityGroupRuleRegistry.Inline(groupID, ruleType) {
+		return fmt.Errorf("Security Group (%s) %s rules are managed both inline and by standalone rule resources; use only one of them", groupID, ruleType)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,231 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	serviceCatalogConstraintTypeLaunch         = "LAUNCH"
+	serviceCatalogConstraintTypeNotification   = "NOTIFICATION"
+	serviceCatalogConstraintTypeResourceUpdate = "RESOURCE_UPDATE"
+	serviceCatalogConstraintTypeStackset       = "STACKSET"
+	serviceCatalogConstraintTypeTemplate       = "TEMPLATE"
+)
+
+func serviceCatalogConstraintTypeValues() []string {
+	return []string{
+		serviceCatalogConstraintTypeLaunch,
+		serviceCatalogConstraintTypeNotification,
+		serviceCatalogConstraintTypeResourceUpdate,
+		serviceCatalogConstraintTypeStackset,
+		serviceCatalogConstraintTypeTemplate,
+	}
+}
+
+func resourceAwsServiceCatalogConstraint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogConstraintCreate,
+		Read:   resourceAwsServiceCatalogConstraintRead,
+		Update: resourceAwsServiceCatalogConstraintUpdate,
+		Delete: resourceAwsServiceCatalogConstraintDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accept_language": serviceCatalogAcceptLanguageSchema(),
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parameters": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(serviceCatalogConstraintTypeValues(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogConstraintCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	acceptLanguage := d.Get("accept_language").(string)
+
+	input := &servicecatalog.CreateConstraintInput{
+		AcceptLanguage:   aws.String(acceptLanguage),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		Parameters:       aws.String(d.Get("parameters").(string)),
+		PortfolioId:      aws.String(d.Get("portfolio_id").(string)),
+		ProductId:        aws.String(d.Get("product_id").(string)),
+		Type:             aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Constraint: %s", input)
+	var output *servicecatalog.CreateConstraintOutput
+
+	// Launch constraints may reference a newly created IAM role.
+	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
+		var err error
+
+		output, err = conn.CreateConstraint(input)
+
+		if tfawserr.ErrMessageContains(err, servicecatalog.ErrCodeInvalidParametersException, "Access denied while assuming the role") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		output, err = conn.CreateConstraint(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog Constraint: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.ConstraintDetail.ConstraintId))
+
+	if _, err := waiter.ConstraintReady(conn, acceptLanguage, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Constraint (%s) to become available: %w", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogConstraintRead(d, meta)
+}
+
+func resourceAwsServiceCatalogConstraintRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	acceptLanguage := d.Get("accept_language").(string)
+
+	if acceptLanguage == "" {
+		acceptLanguage = serviceCatalogAcceptLanguageEnglish
+	}
+
+	input := &servicecatalog.DescribeConstraintInput{
+		AcceptLanguage: aws.String(acceptLanguage),
+		Id:             aws.String(d.Id()),
+	}
+
+	output, err := conn.DescribeConstraint(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Service Catalog Constraint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Constraint (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.ConstraintDetail == nil {
+		return fmt.Errorf("error reading Service Catalog Constraint (%s): empty response", d.Id())
+	}
+
+	detail := output.ConstraintDetail
+
+	d.Set("accept_language", acceptLanguage)
+	d.Set("description", detail.Description)
+	d.Set("owner", detail.Owner)
+	d.Set("parameters", output.ConstraintParameters)
+	d.Set("portfolio_id", detail.PortfolioId)
+	d.Set("product_id", detail.ProductId)
+	d.Set("status", output.Status)
+	d.Set("type", detail.Type)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogConstraintUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.UpdateConstraintInput{
+		AcceptLanguage: aws.String(d.Get("accept_language").(string)),
+		Id:             aws.String(d.Id()),
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("parameters") {
+		input.Parameters = aws.String(d.Get("parameters").(string))
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Constraint: %s", input)
+	if _, err := conn.UpdateConstraint(input); err != nil {
+		return fmt.Errorf("error updating Service Catalog Constraint (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogConstraintRead(d, meta)
+}
+
+func resourceAwsServiceCatalogConstraintDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.DeleteConstraintInput{
+		AcceptLanguage: aws.String(d.Get("accept_language").(string)),
+		Id:             aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog Constraint: %s", d.Id())
+	_, err := conn.DeleteConstraint(input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog Constraint (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,228 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfservicecatalog "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/waiter"
+)
+
+func resourceAwsServiceCatalogPortfolioShare() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogPortfolioShareCreate,
+		Read:   resourceAwsServiceCatalogPortfolioShareRead,
+		Update: resourceAwsServiceCatalogPortfolioShareUpdate,
+		Delete: resourceAwsServiceCatalogPortfolioShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accept_language": serviceCatalogAcceptLanguageSchema(),
+			"accepted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"principal_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"share_tag_options": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(servicecatalog.OrganizationNodeType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogPortfolioShareCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID := d.Get("portfolio_id").(string)
+	principalID := d.Get("principal_id").(string)
+	shareType := d.Get("type").(string)
+
+	input := &servicecatalog.CreatePortfolioShareInput{
+		AcceptLanguage:  aws.String(d.Get("accept_language").(string)),
+		PortfolioId:     aws.String(portfolioID),
+		ShareTagOptions: aws.Bool(d.Get("share_tag_options").(bool)),
+	}
+
+	if shareType == servicecatalog.OrganizationNodeTypeAccount {
+		input.AccountId = aws.String(principalID)
+	} else {
+		input.OrganizationNode = &servicecatalog.OrganizationNode{
+			Type:  aws.String(shareType),
+			Value: aws.String(principalID),
+		}
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Portfolio Share: %s", input)
+	output, err := conn.CreatePortfolioShare(input)
+
+	if err != nil {
+		return fmt.Errorf("error sharing Service Catalog Portfolio (%s) with %s (%s): %w", portfolioID, shareType, principalID, err)
+	}
+
+	d.SetId(tfservicecatalog.PortfolioShareCreateID(portfolioID, shareType, principalID))
+
+	// Only organization shares are asynchronous.
+	if token := aws.StringValue(output.PortfolioShareToken); token != "" {
+		if _, err := waiter.PortfolioShareReady(conn, token); err != nil {
+			return fmt.Errorf("error waiting for Service Catalog Portfolio Share (%s) to complete: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsServiceCatalogPortfolioShareRead(d, meta)
+}
+
+func resourceAwsServiceCatalogPortfolioShareRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, shareType, principalID, err := tfservicecatalog.PortfolioShareParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	share, err := finder.PortfolioShare(conn, portfolioID, shareType, principalID)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Service Catalog Portfolio Share (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Portfolio Share (%s): %w", d.Id(), err)
+	}
+
+	if share == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Service Catalog Portfolio Share (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Service Catalog Portfolio Share (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	acceptLanguage := d.Get("accept_language").(string)
+
+	if acceptLanguage == "" {
+		acceptLanguage = serviceCatalogAcceptLanguageEnglish
+	}
+
+	d.Set("accept_language", acceptLanguage)
+	d.Set("accepted", share.Accepted)
+	d.Set("portfolio_id", portfolioID)
+	d.Set("principal_id", share.PrincipalId)
+	d.Set("share_tag_options", share.ShareTagOptions)
+	d.Set("type", share.Type)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogPortfolioShareUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, shareType, principalID, err := tfservicecatalog.PortfolioShareParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.UpdatePortfolioShareInput{
+		AcceptLanguage:  aws.String(d.Get("accept_language").(string)),
+		PortfolioId:     aws.String(portfolioID),
+		ShareTagOptions: aws.Bool(d.Get("share_tag_options").(bool)),
+	}
+
+	if shareType == servicecatalog.OrganizationNodeTypeAccount {
+		input.AccountId = aws.String(principalID)
+	} else {
+		input.OrganizationNode = &servicecatalog.OrganizationNode{
+			Type:  aws.String(shareType),
+			Value: aws.String(principalID),
+		}
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Portfolio Share: %s", input)
+	output, err := conn.UpdatePortfolioShare(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Service Catalog Portfolio Share (%s): %w", d.Id(), err)
+	}
+
+	if token := aws.StringValue(output.PortfolioShareToken); token != "" {
+		if _, err := waiter.PortfolioShareReady(conn, token); err != nil {
+			return fmt.Errorf("error waiting for Service Catalog Portfolio Share (%s) update to complete: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsServiceCatalogPortfolioShareRead(d, meta)
+}
+
+func resourceAwsServiceCatalogPortfolioShareDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, shareType, principalID, err := tfservicecatalog.PortfolioShareParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.DeletePortfolioShareInput{
+		AcceptLanguage: aws.String(d.Get("accept_language").(string)),
+		PortfolioId:    aws.String(portfolioID),
+	}
+
+	if shareType == servicecatalog.OrganizationNodeTypeAccount {
+		input.AccountId = aws.String(principalID)
+	} else {
+		input.OrganizationNode = &servicecatalog.OrganizationNode{
+			Type:  aws.String(shareType),
+			Value: aws.String(principalID),
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog Portfolio Share: %s", d.Id())
+	output, err := conn.DeletePortfolioShare(input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog Portfolio Share (%s): %w", d.Id(), err)
+	}
+
+	if token := aws.StringValue(output.PortfolioShareToken); token != "" {
+		if _, err := waiter.PortfolioShareReady(conn, token); err != nil {
+			return fmt.Errorf("error waiting for Service Catalog Portfolio Share (%s) deletion to complete: %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfservicecatalog "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+)
+
+func TestAccAWSServiceCatalogPortfolioShare_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_servicecatalog_portfolio_share.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsServiceCatalogPortfolioShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogPortfolioShareConfigBasic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogPortfolioShareExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "accept_language", "en"),
+					resource.TestCheckResourceAttr(resourceName, "accepted", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "portfolio_id", "aws_servicecatalog_portfolio.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "principal_id", "data.aws_caller_identity.alternate", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "share_tag_options", "false"),
+					resource.TestCheckResourceAttr(resourceName, "type", servicecatalog.OrganizationNodeTypeAccount),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSServiceCatalogPortfolioShareConfigBasic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogPortfolioShareExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "share_tag_options", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogPortfolioShare_disappears(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_servicecatalog_portfolio_share.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsServiceCatalogPortfolioShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogPortfolioShareConfigBasic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogPortfolioShareExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsServiceCatalogPortfolioShare(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsServiceCatalogPortfolioShareDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_portfolio_share" {
+			continue
+		}
+
+		portfolioID, shareType, principalID, err := tfservicecatalog.PortfolioShareParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		share, err := finder.PortfolioShare(conn, portfolioID, shareType, principalID)
+
+		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading Service Catalog Portfolio Share (%s): %w", rs.Primary.ID, err)
+		}
+
+		if share != nil {
+			return fmt.Errorf("Service Catalog Portfolio Share (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsServiceCatalogPortfolioShareExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		portfolioID, shareType, principalID, err := tfservicecatalog.PortfolioShareParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+
+		share, err := finder.PortfolioShare(conn, portfolioID, shareType, principalID)
+
+		if err != nil {
+			return fmt.Errorf("error reading Service Catalog Portfolio Share (%s): %w", rs.Primary.ID, err)
+		}
+
+		if share == nil {
+			return fmt.Errorf("Service Catalog Portfolio Share (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSServiceCatalogPortfolioShareConfigBasic(rName string, shareTagOptions bool) string {
+	return composeConfig(testAccAlternateAccountProviderConfig(), fmt.Sprintf(`
+data "aws_caller_identity" "alternate" {
+  provider = "awsalternate"
+}
+
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = substr(%[1]q, 0, 20)
+  provider_name = "test"
+}
+
+resource "aws_servicecatalog_portfolio_share" "test" {
+  portfolio_id      = aws_servicecatalog_portfolio.test.id
+  principal_id      = data.aws_caller_identity.alternate.account_id
+  share_tag_options = %[2]t
+  type              = "ACCOUNT"
+}
+`, rName, shareTagOptions))
+}
@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfservicecatalog "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+)
+
+func resourceAwsServiceCatalogPrincipalPortfolioAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogPrincipalPortfolioAssociationCreate,
+		Read:   resourceAwsServiceCatalogPrincipalPortfolioAssociationRead,
+		Delete: resourceAwsServiceCatalogPrincipalPortfolioAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accept_language": serviceCatalogAcceptLanguageForceNewSchema(),
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"principal_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      servicecatalog.PrincipalTypeIam,
+				ValidateFunc: validation.StringInSlice(servicecatalog.PrincipalType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogPrincipalPortfolioAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID := d.Get("portfolio_id").(string)
+	principalARN := d.Get("principal_arn").(string)
+
+	input := &servicecatalog.AssociatePrincipalWithPortfolioInput{
+		AcceptLanguage: aws.String(d.Get("accept_language").(string)),
+		PortfolioId:    aws.String(portfolioID),
+		PrincipalARN:   aws.String(principalARN),
+		PrincipalType:  aws.String(d.Get("principal_type").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Principal Portfolio Association: %s", input)
+	if _, err := conn.AssociatePrincipalWithPortfolio(input); err != nil {
+		return fmt.Errorf("error associating Service Catalog Principal (%s) with Portfolio (%s): %w", principalARN, portfolioID, err)
+	}
+
+	d.SetId(tfservicecatalog.PrincipalPortfolioAssociationCreateID(portfolioID, principalARN))
+
+	return resourceAwsServiceCatalogPrincipalPortfolioAssociationRead(d, meta)
+}
+
+func resourceAwsServiceCatalogPrincipalPortfolioAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, principalARN, err := tfservicecatalog.PrincipalPortfolioAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	acceptLanguage := d.Get("accept_language").(string)
+
+	if acceptLanguage == "" {
+		acceptLanguage = serviceCatalogAcceptLanguageEnglish
+	}
+
+	principal, err := finder.PrincipalPortfolioAssociation(conn, acceptLanguage, portfolioID, principalARN)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Service Catalog Principal Portfolio Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Principal Portfolio Association (%s): %w", d.Id(), err)
+	}
+
+	if principal == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Service Catalog Principal Portfolio Association (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Service Catalog Principal Portfolio Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("accept_language", acceptLanguage)
+	d.Set("portfolio_id", portfolioID)
+	d.Set("principal_arn", principal.PrincipalARN)
+	d.Set("principal_type", principal.PrincipalType)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogPrincipalPortfolioAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, principalARN, err := tfservicecatalog.PrincipalPortfolioAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.DisassociatePrincipalFromPortfolioInput{
+		AcceptLanguage: aws.String(d.Get("accept_language").(string)),
+		PortfolioId:    aws.String(portfolioID),
+		PrincipalARN:   aws.String(principalARN),
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog Principal Portfolio Association: %s", d.Id())
+	_, err = conn.DisassociatePrincipalFromPortfolio(input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating Service Catalog Principal (%s) from Portfolio (%s): %w", principalARN, portfolioID, err)
+	}
+
+	return nil
+}
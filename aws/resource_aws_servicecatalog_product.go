@@ -0,0 +1,346 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/waiter"
+)
+
+func resourceAwsServiceCatalogProduct() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProductCreate,
+		Read:   resourceAwsServiceCatalogProductRead,
+		Update: resourceAwsServiceCatalogProductUpdate,
+		Delete: resourceAwsServiceCatalogProductDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accept_language": serviceCatalogAcceptLanguageSchema(),
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"distributor": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"has_default_path": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provisioning_artifact_parameters": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"disable_template_validation": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"template_physical_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"provisioning_artifact_parameters.0.template_physical_id", "provisioning_artifact_parameters.0.template_url"},
+						},
+						"template_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"provisioning_artifact_parameters.0.template_physical_id", "provisioning_artifact_parameters.0.template_url"},
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(servicecatalog.ProvisioningArtifactType_Values(), false),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"support_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"support_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"support_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(servicecatalog.ProductType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProductCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	acceptLanguage := d.Get("accept_language").(string)
+	name := d.Get("name").(string)
+
+	input := &servicecatalog.CreateProductInput{
+		AcceptLanguage:                 aws.String(acceptLanguage),
+		IdempotencyToken:               aws.String(resource.UniqueId()),
+		Name:                           aws.String(name),
+		Owner:                          aws.String(d.Get("owner").(string)),
+		ProductType:                    aws.String(d.Get("type").(string)),
+		ProvisioningArtifactParameters: expandServiceCatalogProvisioningArtifactProperties(d.Get("provisioning_artifact_parameters").([]interface{})),
+		Tags:                           keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().ServicecatalogTags(),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("distributor"); ok {
+		input.Distributor = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("support_description"); ok {
+		input.SupportDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("support_email"); ok {
+		input.SupportEmail = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("support_url"); ok {
+		input.SupportUrl = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Product: %s", input)
+	output, err := conn.CreateProduct(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog Product (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ProductViewDetail.ProductViewSummary.ProductId))
+
+	if _, err := waiter.ProductReady(conn, acceptLanguage, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Product (%s) to become available: %w", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProductRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProductRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	acceptLanguage := d.Get("accept_language").(string)
+
+	if acceptLanguage == "" {
+		acceptLanguage = serviceCatalogAcceptLanguageEnglish
+	}
+
+	input := &servicecatalog.DescribeProductAsAdminInput{
+		AcceptLanguage: aws.String(acceptLanguage),
+		Id:             aws.String(d.Id()),
+	}
+
+	output, err := conn.DescribeProductAsAdmin(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Service Catalog Product (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Product (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.ProductViewDetail == nil || output.ProductViewDetail.ProductViewSummary == nil {
+		return fmt.Errorf("error reading Service Catalog Product (%s): empty response", d.Id())
+	}
+
+	detail := output.ProductViewDetail
+	summary := detail.ProductViewSummary
+
+	d.Set("accept_language", acceptLanguage)
+	d.Set("arn", detail.ProductARN)
+	if detail.CreatedTime != nil {
+		d.Set("created_time", aws.TimeValue(detail.CreatedTime).Format(time.RFC3339))
+	}
+	d.Set("description", summary.ShortDescription)
+	d.Set("distributor", summary.Distributor)
+	d.Set("has_default_path", summary.HasDefaultPath)
+	d.Set("name", summary.Name)
+	d.Set("owner", summary.Owner)
+	d.Set("status", detail.Status)
+	d.Set("support_description", summary.SupportDescription)
+	d.Set("support_email", summary.SupportEmail)
+	d.Set("support_url", summary.SupportUrl)
+	d.Set("type", summary.Type)
+
+	if err := d.Set("tags", keyvaluetags.ServicecatalogKeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProductUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.UpdateProductInput{
+		AcceptLanguage: aws.String(d.Get("accept_language").(string)),
+		Id:             aws.String(d.Id()),
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("distributor") {
+		input.Distributor = aws.String(d.Get("distributor").(string))
+	}
+
+	if d.HasChange("name") {
+		input.Name = aws.String(d.Get("name").(string))
+	}
+
+	if d.HasChange("owner") {
+		input.Owner = aws.String(d.Get("owner").(string))
+	}
+
+	if d.HasChange("support_description") {
+		input.SupportDescription = aws.String(d.Get("support_description").(string))
+	}
+
+	if d.HasChange("support_email") {
+		input.SupportEmail = aws.String(d.Get("support_email").(string))
+	}
+
+	if d.HasChange("support_url") {
+		input.SupportUrl = aws.String(d.Get("support_url").(string))
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+		oldTags := keyvaluetags.New(o).IgnoreAws()
+		newTags := keyvaluetags.New(n).IgnoreAws()
+
+		input.AddTags = newTags.ServicecatalogTags()
+		input.RemoveTags = aws.StringSlice(oldTags.Removed(newTags).Keys())
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Product: %s", input)
+	if _, err := conn.UpdateProduct(input); err != nil {
+		return fmt.Errorf("error updating Service Catalog Product (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProductRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProductDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.DeleteProductInput{
+		AcceptLanguage: aws.String(d.Get("accept_language").(string)),
+		Id:             aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog Product: %s", d.Id())
+	_, err := conn.DeleteProduct(input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog Product (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandServiceCatalogProvisioningArtifactProperties(tfList []interface{}) *servicecatalog.ProvisioningArtifactProperties {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &servicecatalog.ProvisioningArtifactProperties{
+		Info: expandServiceCatalogProvisioningArtifactInfo(tfMap["template_url"].(string), tfMap["template_physical_id"].(string)),
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["disable_template_validation"].(bool); ok && v {
+		apiObject.DisableTemplateValidation = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
@@ -0,0 +1,143 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfservicecatalog "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+)
+
+func resourceAwsServiceCatalogProductPortfolioAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProductPortfolioAssociationCreate,
+		Read:   resourceAwsServiceCatalogProductPortfolioAssociationRead,
+		Delete: resourceAwsServiceCatalogProductPortfolioAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accept_language": serviceCatalogAcceptLanguageForceNewSchema(),
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_portfolio_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProductPortfolioAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID := d.Get("portfolio_id").(string)
+	productID := d.Get("product_id").(string)
+
+	input := &servicecatalog.AssociateProductWithPortfolioInput{
+		AcceptLanguage: aws.String(d.Get("accept_language").(string)),
+		PortfolioId:    aws.String(portfolioID),
+		ProductId:      aws.String(productID),
+	}
+
+	if v, ok := d.GetOk("source_portfolio_id"); ok {
+		input.SourcePortfolioId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Product Portfolio Association: %s", input)
+	if _, err := conn.AssociateProductWithPortfolio(input); err != nil {
+		return fmt.Errorf("error associating Service Catalog Product (%s) with Portfolio (%s): %w", productID, portfolioID, err)
+	}
+
+	d.SetId(tfservicecatalog.ProductPortfolioAssociationCreateID(portfolioID, productID))
+
+	return resourceAwsServiceCatalogProductPortfolioAssociationRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProductPortfolioAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, productID, err := tfservicecatalog.ProductPortfolioAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	acceptLanguage := d.Get("accept_language").(string)
+
+	if acceptLanguage == "" {
+		acceptLanguage = serviceCatalogAcceptLanguageEnglish
+	}
+
+	portfolio, err := finder.ProductPortfolioAssociation(conn, acceptLanguage, portfolioID, productID)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Service Catalog Product Portfolio Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Product Portfolio Association (%s): %w", d.Id(), err)
+	}
+
+	if portfolio == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Service Catalog Product Portfolio Association (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Service Catalog Product Portfolio Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("accept_language", acceptLanguage)
+	d.Set("portfolio_id", portfolioID)
+	d.Set("product_id", productID)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProductPortfolioAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, productID, err := tfservicecatalog.ProductPortfolioAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.DisassociateProductFromPortfolioInput{
+		AcceptLanguage: aws.String(d.Get("accept_language").(string)),
+		PortfolioId:    aws.String(portfolioID),
+		ProductId:      aws.String(productID),
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog Product Portfolio Association: %s", d.Id())
+	_, err = conn.DisassociateProductFromPortfolio(input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating Service Catalog Product (%s) from Portfolio (%s): %w", productID, portfolioID, err)
+	}
+
+	return nil
+}
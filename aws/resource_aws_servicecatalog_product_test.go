@@ -0,0 +1,227 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSServiceCatalogProduct_basic(t *testing.T) {
+	resourceName := "aws_servicecatalog_product.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProductConfigBasic(rName, "test description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogProductExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "accept_language", "en"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "catalog", regexp.MustCompile(`product/prod-.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "distributor", "test distributor"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "owner", "test owner"),
+					resource.TestCheckResourceAttr(resourceName, "status", servicecatalog.StatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "support_description", "test support description"),
+					resource.TestCheckResourceAttr(resourceName, "support_email", "support@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "support_url", "http://example.com"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", servicecatalog.ProductTypeCloudFormationTemplate),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"provisioning_artifact_parameters",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogProduct_disappears(t *testing.T) {
+	resourceName := "aws_servicecatalog_product.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProductConfigBasic(rName, "test description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogProductExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsServiceCatalogProduct(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogProduct_update(t *testing.T) {
+	resourceName := "aws_servicecatalog_product.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProductConfigBasic(rName, "test description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogProductExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+				),
+			},
+			{
+				Config: testAccAWSServiceCatalogProductConfigBasic(rName, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogProductExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsServiceCatalogProductDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_product" {
+			continue
+		}
+
+		input := &servicecatalog.DescribeProductAsAdminInput{
+			Id: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.DescribeProductAsAdmin(input)
+
+		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error getting Service Catalog Product (%s): %w", rs.Primary.ID, err)
+		}
+
+		if output != nil {
+			return fmt.Errorf("Service Catalog Product (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsServiceCatalogProductExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+
+		input := &servicecatalog.DescribeProductAsAdminInput{
+			Id: aws.String(rs.Primary.ID),
+		}
+
+		if _, err := conn.DescribeProductAsAdmin(input); err != nil {
+			return fmt.Errorf("error describing Service Catalog Product (%s): %w", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+// testAccAWSServiceCatalogProductConfigTemplateBase uploads a CloudFormation template that can be used by products and provisioning artifacts.
+func testAccAWSServiceCatalogProductConfigTemplateBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "%[1]s.json"
+
+  content = jsonencode({
+    AWSTemplateFormatVersion = "2010-09-09"
+
+    Parameters = {
+      VPCCIDR = {
+        Type    = "String"
+        Default = "10.0.0.0/16"
+      }
+    }
+
+    Resources = {
+      MyVPC = {
+        Type = "AWS::EC2::VPC"
+        Properties = {
+          CidrBlock = { Ref = "VPCCIDR" }
+        }
+      }
+    }
+
+    Outputs = {
+      VpcID = {
+        Description = "VPC ID"
+        Value = {
+          Ref = "MyVPC"
+        }
+      }
+    }
+  })
+}
+`, rName)
+}
+
+func testAccAWSServiceCatalogProductConfigBasic(rName, description string) string {
+	return composeConfig(testAccAWSServiceCatalogProductConfigTemplateBase(rName), fmt.Sprintf(`
+resource "aws_servicecatalog_product" "test" {
+  description         = %[2]q
+  distributor         = "test distributor"
+  name                = %[1]q
+  owner               = "test owner"
+  type                = "CLOUD_FORMATION_TEMPLATE"
+  support_description = "test support description"
+  support_email       = "support@example.com"
+  support_url         = "http://example.com"
+
+  provisioning_artifact_parameters {
+    description                 = "test artifact description"
+    disable_template_validation = true
+    name                        = %[1]q
+    template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_bucket_object.test.key}"
+    type                        = "CLOUD_FORMATION_TEMPLATE"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, description))
+}
@@ -0,0 +1,430 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/waiter"
+)
+
+func resourceAwsServiceCatalogProvisionedProduct() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProvisionedProductCreate,
+		Read:   resourceAwsServiceCatalogProvisionedProductRead,
+		Update: resourceAwsServiceCatalogProvisionedProductUpdate,
+		Delete: resourceAwsServiceCatalogProvisionedProductDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accept_language": serviceCatalogAcceptLanguageSchema(),
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ignore_errors": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"last_record_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"launch_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"notification_arns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"outputs": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"path_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provisioning_artifact_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provisioning_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"use_previous_value": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"retain_physical_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProvisionedProductCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	acceptLanguage := d.Get("accept_language").(string)
+	name := d.Get("name").(string)
+
+	input := &servicecatalog.ProvisionProductInput{
+		AcceptLanguage:         aws.String(acceptLanguage),
+		ProductId:              aws.String(d.Get("product_id").(string)),
+		ProvisionToken:         aws.String(resource.UniqueId()),
+		ProvisionedProductName: aws.String(name),
+		ProvisioningArtifactId: aws.String(d.Get("provisioning_artifact_id").(string)),
+		Tags:                   keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().ServicecatalogTags(),
+	}
+
+	if v, ok := d.GetOk("notification_arns"); ok && len(v.([]interface{})) > 0 {
+		input.NotificationArns = expandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("path_id"); ok {
+		input.PathId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("provisioning_parameters"); ok && len(v.([]interface{})) > 0 {
+		input.ProvisioningParameters = expandServiceCatalogProvisioningParameters(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Provisioned Product: %s", input)
+	output, err := conn.ProvisionProduct(input)
+
+	if err != nil {
+		return fmt.Errorf("error provisioning Service Catalog Product (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.RecordDetail.ProvisionedProductId))
+
+	if _, err := waiter.RecordReady(conn, acceptLanguage, aws.StringValue(output.RecordDetail.RecordId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Provisioned Product (%s) to be provisioned: %w", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProvisionedProductRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisionedProductRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	acceptLanguage := d.Get("accept_language").(string)
+
+	if acceptLanguage == "" {
+		acceptLanguage = serviceCatalogAcceptLanguageEnglish
+	}
+
+	input := &servicecatalog.DescribeProvisionedProductInput{
+		AcceptLanguage: aws.String(acceptLanguage),
+		Id:             aws.String(d.Id()),
+	}
+
+	output, err := conn.DescribeProvisionedProduct(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Service Catalog Provisioned Product (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Provisioned Product (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.ProvisionedProductDetail == nil {
+		return fmt.Errorf("error reading Service Catalog Provisioned Product (%s): empty response", d.Id())
+	}
+
+	detail := output.ProvisionedProductDetail
+
+	d.Set("accept_language", acceptLanguage)
+	d.Set("arn", detail.Arn)
+	if detail.CreatedTime != nil {
+		d.Set("created_time", aws.TimeValue(detail.CreatedTime).Format(time.RFC3339))
+	}
+	d.Set("last_record_id", detail.LastRecordId)
+	d.Set("launch_role_arn", detail.LaunchRoleArn)
+	d.Set("name", detail.Name)
+	d.Set("product_id", detail.ProductId)
+	d.Set("provisioning_artifact_id", detail.ProvisioningArtifactId)
+	d.Set("status", detail.Status)
+	d.Set("status_message", detail.StatusMessage)
+	d.Set("type", detail.Type)
+
+	// Outputs, path and tags are only available from the provisioning record.
+	recordID := aws.StringValue(detail.LastSuccessfulProvisioningRecordId)
+
+	if recordID == "" {
+		recordID = aws.StringValue(detail.LastProvisioningRecordId)
+	}
+
+	if recordID == "" {
+		return nil
+	}
+
+	recordOutput, err := conn.DescribeRecord(&servicecatalog.DescribeRecordInput{
+		AcceptLanguage: aws.String(acceptLanguage),
+		Id:             aws.String(recordID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Provisioned Product (%s) record (%s): %w", d.Id(), recordID, err)
+	}
+
+	if recordOutput == nil || recordOutput.RecordDetail == nil {
+		return fmt.Errorf("error reading Service Catalog Provisioned Product (%s) record (%s): empty response", d.Id(), recordID)
+	}
+
+	d.Set("path_id", recordOutput.RecordDetail.PathId)
+
+	if err := d.Set("outputs", flattenServiceCatalogRecordOutputs(recordOutput.RecordOutputs)); err != nil {
+		return fmt.Errorf("error setting outputs: %w", err)
+	}
+
+	tags := keyvaluetags.New(flattenServiceCatalogRecordTags(recordOutput.RecordDetail.RecordTags))
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProvisionedProductUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	acceptLanguage := d.Get("accept_language").(string)
+
+	input := &servicecatalog.UpdateProvisionedProductInput{
+		AcceptLanguage:         aws.String(acceptLanguage),
+		ProductId:              aws.String(d.Get("product_id").(string)),
+		ProvisionedProductId:   aws.String(d.Id()),
+		ProvisioningArtifactId: aws.String(d.Get("provisioning_artifact_id").(string)),
+		UpdateToken:            aws.String(resource.UniqueId()),
+	}
+
+	if v, ok := d.GetOk("path_id"); ok {
+		input.PathId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("provisioning_parameters"); ok && len(v.([]interface{})) > 0 {
+		input.ProvisioningParameters = expandServiceCatalogUpdateProvisioningParameters(v.([]interface{}))
+	}
+
+	if d.HasChange("tags") {
+		// The full set of tags replaces the existing tags.
+		input.Tags = keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().ServicecatalogTags()
+	}
+
+	if d.HasChanges("path_id", "product_id", "provisioning_artifact_id", "provisioning_parameters", "tags") {
+		log.Printf("[DEBUG] Updating Service Catalog Provisioned Product: %s", input)
+		output, err := conn.UpdateProvisionedProduct(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Service Catalog Provisioned Product (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.RecordReady(conn, acceptLanguage, aws.StringValue(output.RecordDetail.RecordId), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Service Catalog Provisioned Product (%s) to be updated: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsServiceCatalogProvisionedProductRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisionedProductDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	acceptLanguage := d.Get("accept_language").(string)
+
+	input := &servicecatalog.TerminateProvisionedProductInput{
+		AcceptLanguage:          aws.String(acceptLanguage),
+		IgnoreErrors:            aws.Bool(d.Get("ignore_errors").(bool)),
+		ProvisionedProductId:    aws.String(d.Id()),
+		RetainPhysicalResources: aws.Bool(d.Get("retain_physical_resources").(bool)),
+		TerminateToken:          aws.String(resource.UniqueId()),
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog Provisioned Product: %s", d.Id())
+	output, err := conn.TerminateProvisionedProduct(input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error terminating Service Catalog Provisioned Product (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.RecordReady(conn, acceptLanguage, aws.StringValue(output.RecordDetail.RecordId), d.Timeout(schema.TimeoutDelete)); err != nil {
+		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+
+		return fmt.Errorf("error waiting for Service Catalog Provisioned Product (%s) to be terminated: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandServiceCatalogProvisioningParameters(tfList []interface{}) []*servicecatalog.ProvisioningParameter {
+	var apiObjects []*servicecatalog.ProvisioningParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &servicecatalog.ProvisioningParameter{
+			Key:   aws.String(tfMap["key"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandServiceCatalogUpdateProvisioningParameters(tfList []interface{}) []*servicecatalog.UpdateProvisioningParameter {
+	var apiObjects []*servicecatalog.UpdateProvisioningParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &servicecatalog.UpdateProvisioningParameter{
+			Key: aws.String(tfMap["key"].(string)),
+		}
+
+		if tfMap["use_previous_value"].(bool) {
+			apiObject.UsePreviousValue = aws.Bool(true)
+		} else {
+			apiObject.Value = aws.String(tfMap["value"].(string))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenServiceCatalogRecordOutputs(apiObjects []*servicecatalog.RecordOutput) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"description": aws.StringValue(apiObject.Description),
+			"key":         aws.StringValue(apiObject.OutputKey),
+			"value":       aws.StringValue(apiObject.OutputValue),
+		})
+	}
+
+	return tfList
+}
+
+func flattenServiceCatalogRecordTags(apiObjects []*servicecatalog.RecordTag) map[string]string {
+	m := make(map[string]string, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		m[aws.StringValue(apiObject.Key)] = aws.StringValue(apiObject.Value)
+	}
+
+	return m
+}
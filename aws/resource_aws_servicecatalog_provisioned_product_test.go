@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSServiceCatalogProvisionedProduct_basic(t *testing.T) {
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogProvisionedProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProvisionedProductConfigBasic(rName, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogProvisionedProductExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "accept_language", "en"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "servicecatalog", regexp.MustCompile(`stack/.+/pp-.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_record_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "path_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "product_id", "aws_servicecatalog_product.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "provisioning_artifact_id", "aws_servicecatalog_provisioning_artifact.test", "provisioning_artifact_id"),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", servicecatalog.ProvisionedProductStatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "CFN_STACK"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"ignore_errors",
+					"provisioning_parameters",
+					"retain_physical_resources",
+				},
+			},
+			{
+				Config: testAccAWSServiceCatalogProvisionedProductConfigBasic(rName, "10.2.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogProvisionedProductExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_parameters.0.value", "10.2.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "status", servicecatalog.ProvisionedProductStatusAvailable),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogProvisionedProduct_disappears(t *testing.T) {
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogProvisionedProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProvisionedProductConfigBasic(rName, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogProvisionedProductExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsServiceCatalogProvisionedProduct(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsServiceCatalogProvisionedProductDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_provisioned_product" {
+			continue
+		}
+
+		input := &servicecatalog.DescribeProvisionedProductInput{
+			Id: aws.String(rs.Primary.ID),
+		}
+
+		_, err := conn.DescribeProvisionedProduct(input)
+
+		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error getting Service Catalog Provisioned Product (%s): %w", rs.Primary.ID, err)
+		}
+
+		return fmt.Errorf("Service Catalog Provisioned Product (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsServiceCatalogProvisionedProductExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+
+		input := &servicecatalog.DescribeProvisionedProductInput{
+			Id: aws.String(rs.Primary.ID),
+		}
+
+		if _, err := conn.DescribeProvisionedProduct(input); err != nil {
+			return fmt.Errorf("error describing Service Catalog Provisioned Product (%s): %w", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSServiceCatalogProvisionedProductConfigBasic(rName, vpcCidr string) string {
+	return composeConfig(testAccAWSServiceCatalogProductPortfolioAssociationConfigBasic(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_servicecatalog_principal_portfolio_association" "test" {
+  portfolio_id  = aws_servicecatalog_portfolio.test.id
+  principal_arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  name                        = "%[1]s-artifact"
+  product_id                  = aws_servicecatalog_product.test.id
+  disable_template_validation = true
+  template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_bucket_object.test.key}"
+  type                        = "CLOUD_FORMATION_TEMPLATE"
+}
+
+resource "aws_servicecatalog_provisioned_product" "test" {
+  name                     = %[1]q
+  product_id               = aws_servicecatalog_product_portfolio_association.test.product_id
+  provisioning_artifact_id = aws_servicecatalog_provisioning_artifact.test.provisioning_artifact_id
+
+  provisioning_parameters {
+    key   = "VPCCIDR"
+    value = %[2]q
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_servicecatalog_principal_portfolio_association.test]
+}
+`, rName, vpcCidr))
+}
@@ -0,0 +1,263 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfservicecatalog "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/waiter"
+)
+
+func resourceAwsServiceCatalogProvisioningArtifact() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProvisioningArtifactCreate,
+		Read:   resourceAwsServiceCatalogProvisioningArtifactRead,
+		Update: resourceAwsServiceCatalogProvisioningArtifactUpdate,
+		Delete: resourceAwsServiceCatalogProvisioningArtifactDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accept_language": serviceCatalogAcceptLanguageSchema(),
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"disable_template_validation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"guidance": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      servicecatalog.ProvisioningArtifactGuidanceDefault,
+				ValidateFunc: validation.StringInSlice(servicecatalog.ProvisioningArtifactGuidance_Values(), false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"provisioning_artifact_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"template_physical_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"template_physical_id", "template_url"},
+			},
+			"template_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"template_physical_id", "template_url"},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(servicecatalog.ProvisioningArtifactType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	acceptLanguage := d.Get("accept_language").(string)
+	productID := d.Get("product_id").(string)
+
+	parameters := &servicecatalog.ProvisioningArtifactProperties{
+		Info: expandServiceCatalogProvisioningArtifactInfo(d.Get("template_url").(string), d.Get("template_physical_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		parameters.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("disable_template_validation"); ok {
+		parameters.DisableTemplateValidation = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		parameters.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		parameters.Type = aws.String(v.(string))
+	}
+
+	input := &servicecatalog.CreateProvisioningArtifactInput{
+		AcceptLanguage:   aws.String(acceptLanguage),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		Parameters:       parameters,
+		ProductId:        aws.String(productID),
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Provisioning Artifact: %s", input)
+	output, err := conn.CreateProvisioningArtifact(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog Provisioning Artifact for Product (%s): %w", productID, err)
+	}
+
+	provisioningArtifactID := aws.StringValue(output.ProvisioningArtifactDetail.Id)
+
+	d.SetId(tfservicecatalog.ProvisioningArtifactCreateID(productID, provisioningArtifactID))
+
+	if _, err := waiter.ProvisioningArtifactReady(conn, acceptLanguage, productID, provisioningArtifactID); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Provisioning Artifact (%s) to become available: %w", d.Id(), err)
+	}
+
+	// Active and guidance can only be set by updating the provisioning artifact.
+	if !d.Get("active").(bool) || d.Get("guidance").(string) != servicecatalog.ProvisioningArtifactGuidanceDefault {
+		return resourceAwsServiceCatalogProvisioningArtifactUpdate(d, meta)
+	}
+
+	return resourceAwsServiceCatalogProvisioningArtifactRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	productID, provisioningArtifactID, err := tfservicecatalog.ProvisioningArtifactParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	acceptLanguage := d.Get("accept_language").(string)
+
+	if acceptLanguage == "" {
+		acceptLanguage = serviceCatalogAcceptLanguageEnglish
+	}
+
+	input := &servicecatalog.DescribeProvisioningArtifactInput{
+		AcceptLanguage:         aws.String(acceptLanguage),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(provisioningArtifactID),
+	}
+
+	output, err := conn.DescribeProvisioningArtifact(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Service Catalog Provisioning Artifact (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Provisioning Artifact (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.ProvisioningArtifactDetail == nil {
+		return fmt.Errorf("error reading Service Catalog Provisioning Artifact (%s): empty response", d.Id())
+	}
+
+	detail := output.ProvisioningArtifactDetail
+
+	d.Set("accept_language", acceptLanguage)
+	d.Set("active", detail.Active)
+	if detail.CreatedTime != nil {
+		d.Set("created_time", aws.TimeValue(detail.CreatedTime).Format(time.RFC3339))
+	}
+	d.Set("description", detail.Description)
+	d.Set("guidance", detail.Guidance)
+	d.Set("name", detail.Name)
+	d.Set("product_id", productID)
+	d.Set("provisioning_artifact_id", provisioningArtifactID)
+	d.Set("type", detail.Type)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	productID, provisioningArtifactID, err := tfservicecatalog.ProvisioningArtifactParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.UpdateProvisioningArtifactInput{
+		AcceptLanguage:         aws.String(d.Get("accept_language").(string)),
+		Active:                 aws.Bool(d.Get("active").(bool)),
+		Guidance:               aws.String(d.Get("guidance").(string)),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(provisioningArtifactID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Provisioning Artifact: %s", input)
+	if _, err := conn.UpdateProvisioningArtifact(input); err != nil {
+		return fmt.Errorf("error updating Service Catalog Provisioning Artifact (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProvisioningArtifactRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	productID, provisioningArtifactID, err := tfservicecatalog.ProvisioningArtifactParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.DeleteProvisioningArtifactInput{
+		AcceptLanguage:         aws.String(d.Get("accept_language").(string)),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(provisioningArtifactID),
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog Provisioning Artifact: %s", d.Id())
+	_, err = conn.DeleteProvisioningArtifact(input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog Provisioning Artifact (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
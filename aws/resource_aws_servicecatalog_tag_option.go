@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsServiceCatalogTagOption() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogTagOptionCreate,
+		Read:   resourceAwsServiceCatalogTagOptionRead,
+		Update: resourceAwsServiceCatalogTagOptionUpdate,
+		Delete: resourceAwsServiceCatalogTagOptionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogTagOptionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	key := d.Get("key").(string)
+
+	input := &servicecatalog.CreateTagOptionInput{
+		Key:   aws.String(key),
+		Value: aws.String(d.Get("value").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Tag Option: %s", input)
+	output, err := conn.CreateTagOption(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog Tag Option (%s): %w", key, err)
+	}
+
+	d.SetId(aws.StringValue(output.TagOptionDetail.Id))
+
+	// Tag options are always created active.
+	if !d.Get("active").(bool) {
+		return resourceAwsServiceCatalogTagOptionUpdate(d, meta)
+	}
+
+	return resourceAwsServiceCatalogTagOptionRead(d, meta)
+}
+
+func resourceAwsServiceCatalogTagOptionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.DescribeTagOptionInput{
+		Id: aws.String(d.Id()),
+	}
+
+	output, err := conn.DescribeTagOption(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Service Catalog Tag Option (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Tag Option (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.TagOptionDetail == nil {
+		return fmt.Errorf("error reading Service Catalog Tag Option (%s): empty response", d.Id())
+	}
+
+	detail := output.TagOptionDetail
+
+	d.Set("active", detail.Active)
+	d.Set("key", detail.Key)
+	d.Set("owner", detail.Owner)
+	d.Set("value", detail.Value)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogTagOptionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.UpdateTagOptionInput{
+		Active: aws.Bool(d.Get("active").(bool)),
+		Id:     aws.String(d.Id()),
+	}
+
+	if d.HasChange("value") {
+		input.Value = aws.String(d.Get("value").(string))
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Tag Option: %s", input)
+	if _, err := conn.UpdateTagOption(input); err != nil {
+		return fmt.Errorf("error updating Service Catalog Tag Option (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogTagOptionRead(d, meta)
+}
+
+func resourceAwsServiceCatalogTagOptionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.DeleteTagOptionInput{
+		Id: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog Tag Option: %s", d.Id())
+	_, err := conn.DeleteTagOption(input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog Tag Option (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
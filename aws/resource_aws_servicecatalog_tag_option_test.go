@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSServiceCatalogTagOption_basic(t *testing.T) {
+	resourceName := "aws_servicecatalog_tag_option.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogTagOptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogTagOptionConfigBasic(rName, "value1", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogTagOptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+					resource.TestCheckResourceAttr(resourceName, "key", rName),
+					resource.TestCheckResourceAttr(resourceName, "value", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSServiceCatalogTagOptionConfigBasic(rName, "value2", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogTagOptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "active", "false"),
+					resource.TestCheckResourceAttr(resourceName, "key", rName),
+					resource.TestCheckResourceAttr(resourceName, "value", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogTagOption_disappears(t *testing.T) {
+	resourceName := "aws_servicecatalog_tag_option.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogTagOptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogTagOptionConfigBasic(rName, "value1", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogTagOptionExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsServiceCatalogTagOption(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsServiceCatalogTagOptionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_tag_option" {
+			continue
+		}
+
+		input := &servicecatalog.DescribeTagOptionInput{
+			Id: aws.String(rs.Primary.ID),
+		}
+
+		_, err := conn.DescribeTagOption(input)
+
+		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error getting Service Catalog Tag Option (%s): %w", rs.Primary.ID, err)
+		}
+
+		return fmt.Errorf("Service Catalog Tag Option (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsServiceCatalogTagOptionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+
+		input := &servicecatalog.DescribeTagOptionInput{
+			Id: aws.String(rs.Primary.ID),
+		}
+
+		if _, err := conn.DescribeTagOption(input); err != nil {
+			return fmt.Errorf("error describing Service Catalog Tag Option (%s): %w", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSServiceCatalogTagOptionConfigBasic(rName, value string, active bool) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalog_tag_option" "test" {
+  active = %[3]t
+  key    = %[1]q
+  value  = %[2]q
+}
+`, rName, value, active)
+}
@@ -3,27 +3,79 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ses"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAwsSesConfigurationSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsSesConfigurationSetCreate,
 		Read:   resourceAwsSesConfigurationSetRead,
+		Update: resourceAwsSesConfigurationSetUpdate,
 		Delete: resourceAwsSesConfigurationSetDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delivery_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tls_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ses.TlsPolicyOptional,
+							ValidateFunc: validation.StringInSlice(ses.TlsPolicy_Values(), false),
+						},
+					},
+				},
+			},
+			"last_fresh_start": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"reputation_metrics_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"sending_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"tracking_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_redirect_domain": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringDoesNotMatch(regexp.MustCompile(`^\.|\.$`), "cannot start or end with a period"),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -41,11 +93,55 @@ func resourceAwsSesConfigurationSetCreate(d *schema.ResourceData, meta interface
 
 	_, err := conn.CreateConfigurationSet(createOpts)
 	if err != nil {
-		return fmt.Errorf("Error creating SES configuration set: %s", err)
+		return fmt.Errorf("error creating SES configuration set (%s): %w", configurationSetName, err)
 	}
 
 	d.SetId(configurationSetName)
 
+	if v, ok := d.GetOk("delivery_options"); ok && len(v.([]interface{})) > 0 {
+		input := &ses.PutConfigurationSetDeliveryOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+			DeliveryOptions:      expandSesConfigurationSetDeliveryOptions(v.([]interface{})),
+		}
+
+		if _, err := conn.PutConfigurationSetDeliveryOptions(input); err != nil {
+			return fmt.Errorf("error setting SES configuration set (%s) delivery options: %w", d.Id(), err)
+		}
+	}
+
+	if v := d.Get("reputation_metrics_enabled").(bool); v {
+		input := &ses.UpdateConfigurationSetReputationMetricsEnabledInput{
+			ConfigurationSetName: aws.String(d.Id()),
+			Enabled:              aws.Bool(v),
+		}
+
+		if _, err := conn.UpdateConfigurationSetReputationMetricsEnabled(input); err != nil {
+			return fmt.Errorf("error setting SES configuration set (%s) reputation metrics enabled: %w", d.Id(), err)
+		}
+	}
+
+	if v := d.Get("sending_enabled").(bool); !v {
+		input := &ses.UpdateConfigurationSetSendingEnabledInput{
+			ConfigurationSetName: aws.String(d.Id()),
+			Enabled:              aws.Bool(v),
+		}
+
+		if _, err := conn.UpdateConfigurationSetSendingEnabled(input); err != nil {
+			return fmt.Errorf("error setting SES configuration set (%s) sending enabled: %w", d.Id(), err)
+		}
+	}
+
+	if v, ok := d.GetOk("tracking_options"); ok && len(v.([]interface{})) > 0 {
+		input := &ses.CreateConfigurationSetTrackingOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+			TrackingOptions:      expandSesConfigurationSetTrackingOptions(v.([]interface{})),
+		}
+
+		if _, err := conn.CreateConfigurationSetTrackingOptions(input); err != nil {
+			return fmt.Errorf("error creating SES configuration set (%s) tracking options: %w", d.Id(), err)
+		}
+	}
+
 	return resourceAwsSesConfigurationSetRead(d, meta)
 }
 
@@ -54,24 +150,130 @@ func resourceAwsSesConfigurationSetRead(d *schema.ResourceData, meta interface{}
 
 	configSetInput := &ses.DescribeConfigurationSetInput{
 		ConfigurationSetName: aws.String(d.Id()),
+		ConfigurationSetAttributeNames: aws.StringSlice([]string{
+			ses.ConfigurationSetAttributeDeliveryOptions,
+			ses.ConfigurationSetAttributeReputationOptions,
+			ses.ConfigurationSetAttributeTrackingOptions,
+		}),
 	}
 
 	response, err := conn.DescribeConfigurationSet(configSetInput)
 
+	if !d.IsNewResource() && isAWSErr(err, ses.ErrCodeConfigurationSetDoesNotExistException, "") {
+		log.Printf("[WARN] SES Configuration Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	if err != nil {
-		if isAWSErr(err, ses.ErrCodeConfigurationSetDoesNotExistException, "") {
-			log.Printf("[WARN] SES Configuration Set (%s) not found, removing from state", d.Id())
-			d.SetId("")
-			return nil
-		}
-		return err
+		return fmt.Errorf("error reading SES configuration set (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("delivery_options", flattenSesConfigurationSetDeliveryOptions(response.DeliveryOptions)); err != nil {
+		return fmt.Errorf("error setting delivery_options: %w", err)
+	}
+
+	if err := d.Set("tracking_options", flattenSesConfigurationSetTrackingOptions(response.TrackingOptions)); err != nil {
+		return fmt.Errorf("error setting tracking_options: %w", err)
 	}
 
 	d.Set("name", aws.StringValue(response.ConfigurationSet.Name))
 
+	d.Set("last_fresh_start", nil)
+	if repOpts := response.ReputationOptions; repOpts != nil {
+		d.Set("reputation_metrics_enabled", repOpts.ReputationMetricsEnabled)
+		d.Set("sending_enabled", repOpts.SendingEnabled)
+		if repOpts.LastFreshStart != nil {
+			d.Set("last_fresh_start", aws.TimeValue(repOpts.LastFreshStart).Format(time.RFC3339))
+		}
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "ses",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("configuration-set/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+
 	return nil
 }
 
+func resourceAwsSesConfigurationSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesconn
+
+	if d.HasChange("delivery_options") {
+		input := &ses.PutConfigurationSetDeliveryOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+			DeliveryOptions:      expandSesConfigurationSetDeliveryOptions(d.Get("delivery_options").([]interface{})),
+		}
+
+		if _, err := conn.PutConfigurationSetDeliveryOptions(input); err != nil {
+			return fmt.Errorf("error updating SES configuration set (%s) delivery options: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("reputation_metrics_enabled") {
+		input := &ses.UpdateConfigurationSetReputationMetricsEnabledInput{
+			ConfigurationSetName: aws.String(d.Id()),
+			Enabled:              aws.Bool(d.Get("reputation_metrics_enabled").(bool)),
+		}
+
+		if _, err := conn.UpdateConfigurationSetReputationMetricsEnabled(input); err != nil {
+			return fmt.Errorf("error updating SES configuration set (%s) reputation metrics enabled: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("sending_enabled") {
+		input := &ses.UpdateConfigurationSetSendingEnabledInput{
+			ConfigurationSetName: aws.String(d.Id()),
+			Enabled:              aws.Bool(d.Get("sending_enabled").(bool)),
+		}
+
+		if _, err := conn.UpdateConfigurationSetSendingEnabled(input); err != nil {
+			return fmt.Errorf("error updating SES configuration set (%s) sending enabled: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tracking_options") {
+		o, n := d.GetChange("tracking_options")
+
+		switch {
+		case len(n.([]interface{})) == 0:
+			input := &ses.DeleteConfigurationSetTrackingOptionsInput{
+				ConfigurationSetName: aws.String(d.Id()),
+			}
+
+			_, err := conn.DeleteConfigurationSetTrackingOptions(input)
+
+			if err != nil && !isAWSErr(err, ses.ErrCodeTrackingOptionsDoesNotExistException, "") {
+				return fmt.Errorf("error deleting SES configuration set (%s) tracking options: %w", d.Id(), err)
+			}
+		case len(o.([]interface{})) == 0:
+			input := &ses.CreateConfigurationSetTrackingOptionsInput{
+				ConfigurationSetName: aws.String(d.Id()),
+				TrackingOptions:      expandSesConfigurationSetTrackingOptions(n.([]interface{})),
+			}
+
+			if _, err := conn.CreateConfigurationSetTrackingOptions(input); err != nil {
+				return fmt.Errorf("error creating SES configuration set (%s) tracking options: %w", d.Id(), err)
+			}
+		default:
+			input := &ses.UpdateConfigurationSetTrackingOptionsInput{
+				ConfigurationSetName: aws.String(d.Id()),
+				TrackingOptions:      expandSesConfigurationSetTrackingOptions(n.([]interface{})),
+			}
+
+			if _, err := conn.UpdateConfigurationSetTrackingOptions(input); err != nil {
+				return fmt.Errorf("error updating SES configuration set (%s) tracking options: %w", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceAwsSesConfigurationSetRead(d, meta)
+}
+
 func resourceAwsSesConfigurationSetDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).sesconn
 
@@ -80,5 +282,70 @@ func resourceAwsSesConfigurationSetDelete(d *schema.ResourceData, meta interface
 		ConfigurationSetName: aws.String(d.Id()),
 	})
 
-	return err
+	if isAWSErr(err, ses.ErrCodeConfigurationSetDoesNotExistException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SES configuration set (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSesConfigurationSetDeliveryOptions(tfList []interface{}) *ses.DeliveryOptions {
+	// An empty structure resets the TLS policy to its default.
+	apiObject := &ses.DeliveryOptions{}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["tls_policy"].(string); ok && v != "" {
+		apiObject.TlsPolicy = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSesConfigurationSetDeliveryOptions(apiObject *ses.DeliveryOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"tls_policy": aws.StringValue(apiObject.TlsPolicy),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandSesConfigurationSetTrackingOptions(tfList []interface{}) *ses.TrackingOptions {
+	apiObject := &ses.TrackingOptions{}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["custom_redirect_domain"].(string); ok && v != "" {
+		apiObject.CustomRedirectDomain = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSesConfigurationSetTrackingOptions(apiObject *ses.TrackingOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"custom_redirect_domain": aws.StringValue(apiObject.CustomRedirectDomain),
+	}
+
+	return []interface{}{tfMap}
 }
@@ -82,6 +82,11 @@ func TestAccAWSSESConfigurationSet_basic(t *testing.T) {
 				Config: testAccAWSSESConfigurationSetConfig(escRandomInteger),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsSESConfigurationSetExists("aws_ses_configuration_set.test"),
+					testAccCheckResourceAttrRegionalARN("aws_ses_configuration_set.test", "arn", "ses", fmt.Sprintf("configuration-set/some-configuration-set-%d", escRandomInteger)),
+					resource.TestCheckResourceAttr("aws_ses_configuration_set.test", "delivery_options.#", "0"),
+					resource.TestCheckResourceAttr("aws_ses_configuration_set.test", "reputation_metrics_enabled", "false"),
+					resource.TestCheckResourceAttr("aws_ses_configuration_set.test", "sending_enabled", "true"),
+					resource.TestCheckResourceAttr("aws_ses_configuration_set.test", "tracking_options.#", "0"),
 				),
 			},
 			{
@@ -93,6 +98,116 @@ func TestAccAWSSESConfigurationSet_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSSESConfigurationSet_DeliveryOptions(t *testing.T) {
+	resourceName := "aws_ses_configuration_set.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSES(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSESConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESConfigurationSetConfigDeliveryOptions(rName, ses.TlsPolicyRequire),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.0.tls_policy", ses.TlsPolicyRequire),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSESConfigurationSetConfigDeliveryOptions(rName, ses.TlsPolicyOptional),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.0.tls_policy", ses.TlsPolicyOptional),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSESConfigurationSet_ReputationOptions(t *testing.T) {
+	resourceName := "aws_ses_configuration_set.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSES(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSESConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESConfigurationSetConfigReputationOptions(rName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "reputation_metrics_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "sending_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSESConfigurationSetConfigReputationOptions(rName, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "reputation_metrics_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "sending_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSESConfigurationSet_TrackingOptions(t *testing.T) {
+	resourceName := "aws_ses_configuration_set.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSES(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSESConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESConfigurationSetConfigTrackingOptions(rName, "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tracking_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tracking_options.0.custom_redirect_domain", "example.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSESConfigurationSetConfig_Name(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tracking_options.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAwsSESConfigurationSetExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -151,3 +266,45 @@ resource "aws_ses_configuration_set" "test" {
 }
 `, escRandomInteger)
 }
+
+func testAccAWSSESConfigurationSetConfig_Name(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_configuration_set" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSSESConfigurationSetConfigDeliveryOptions(rName, tlsPolicy string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_configuration_set" "test" {
+  name = %[1]q
+
+  delivery_options {
+    tls_policy = %[2]q
+  }
+}
+`, rName, tlsPolicy)
+}
+
+func testAccAWSSESConfigurationSetConfigReputationOptions(rName string, reputationMetricsEnabled, sendingEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_ses_configuration_set" "test" {
+  name                       = %[1]q
+  reputation_metrics_enabled = %[2]t
+  sending_enabled            = %[3]t
+}
+`, rName, reputationMetricsEnabled, sendingEnabled)
+}
+
+func testAccAWSSESConfigurationSetConfigTrackingOptions(rName, customRedirectDomain string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_configuration_set" "test" {
+  name = %[1]q
+
+  tracking_options {
+    custom_redirect_domain = %[2]q
+  }
+}
+`, rName, customRedirectDomain)
+}
@@ -0,0 +1,249 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsSesV2ContactList() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSesV2ContactListCreate,
+		Read:   resourceAwsSesV2ContactListRead,
+		Update: resourceAwsSesV2ContactListUpdate,
+		Delete: resourceAwsSesV2ContactListDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_list_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"created_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"last_updated_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"topic": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_subscription_status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(sesv2.SubscriptionStatus_Values(), false),
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"topic_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsSesV2ContactListCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	name := d.Get("contact_list_name").(string)
+
+	input := &sesv2.CreateContactListInput{
+		ContactListName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().Sesv2Tags()
+	}
+
+	if v, ok := d.GetOk("topic"); ok && v.(*schema.Set).Len() > 0 {
+		input.Topics = expandSesV2Topics(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Creating SES contact list: %s", input)
+	_, err := conn.CreateContactList(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SES contact list (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsSesV2ContactListRead(d, meta)
+}
+
+func resourceAwsSesV2ContactListRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := conn.GetContactList(&sesv2.GetContactListInput{
+		ContactListName: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		log.Printf("[WARN] SES contact list (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SES contact list (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "ses",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("contact-list/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("contact_list_name", output.ContactListName)
+	if output.CreatedTimestamp != nil {
+		d.Set("created_timestamp", aws.TimeValue(output.CreatedTimestamp).Format(time.RFC3339))
+	}
+	d.Set("description", output.Description)
+	if output.LastUpdatedTimestamp != nil {
+		d.Set("last_updated_timestamp", aws.TimeValue(output.LastUpdatedTimestamp).Format(time.RFC3339))
+	}
+
+	if err := d.Set("topic", flattenSesV2Topics(output.Topics)); err != nil {
+		return fmt.Errorf("error setting topic: %w", err)
+	}
+
+	if err := d.Set("tags", keyvaluetags.Sesv2KeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSesV2ContactListUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	if d.HasChanges("description", "topic") {
+		// The full set of topics replaces the existing topics.
+		input := &sesv2.UpdateContactListInput{
+			ContactListName: aws.String(d.Id()),
+			Description:     aws.String(d.Get("description").(string)),
+			Topics:          expandSesV2Topics(d.Get("topic").(*schema.Set).List()),
+		}
+
+		log.Printf("[DEBUG] Updating SES contact list: %s", input)
+		if _, err := conn.UpdateContactList(input); err != nil {
+			return fmt.Errorf("error updating SES contact list (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.Sesv2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating SES contact list (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSesV2ContactListRead(d, meta)
+}
+
+func resourceAwsSesV2ContactListDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	log.Printf("[DEBUG] Deleting SES contact list: %s", d.Id())
+	_, err := conn.DeleteContactList(&sesv2.DeleteContactListInput{
+		ContactListName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SES contact list (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSesV2Topics(tfList []interface{}) []*sesv2.Topic {
+	var apiObjects []*sesv2.Topic
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &sesv2.Topic{
+			DefaultSubscriptionStatus: aws.String(tfMap["default_subscription_status"].(string)),
+			DisplayName:               aws.String(tfMap["display_name"].(string)),
+			TopicName:                 aws.String(tfMap["topic_name"].(string)),
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenSesV2Topics(apiObjects []*sesv2.Topic) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"default_subscription_status": aws.StringValue(apiObject.DefaultSubscriptionStatus),
+			"description":                 aws.StringValue(apiObject.Description),
+			"display_name":                aws.StringValue(apiObject.DisplayName),
+			"topic_name":                  aws.StringValue(apiObject.TopicName),
+		})
+	}
+
+	return tfList
+}
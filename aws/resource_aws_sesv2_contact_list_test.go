@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSSESV2ContactList_basic(t *testing.T) {
+	resourceName := "aws_sesv2_contact_list.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSES(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSESV2ContactListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2ContactListConfig(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESV2ContactListExists(resourceName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "ses", fmt.Sprintf("contact-list/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "contact_list_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "created_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "topic.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "topic.*", map[string]string{
+						"default_subscription_status": sesv2.SubscriptionStatusOptIn,
+						"display_name":                "topic1",
+						"topic_name":                  "topic1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSESV2ContactListConfig(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESV2ContactListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSESV2ContactList_disappears(t *testing.T) {
+	resourceName := "aws_sesv2_contact_list.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSES(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSESV2ContactListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2ContactListConfig(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESV2ContactListExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSesV2ContactList(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSESV2ContactListDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_contact_list" {
+			continue
+		}
+
+		_, err := conn.GetContactList(&sesv2.GetContactListInput{
+			ContactListName: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SES contact list (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSESV2ContactListExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SES contact list ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+		_, err := conn.GetContactList(&sesv2.GetContactListInput{
+			ContactListName: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSSESV2ContactListConfig(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_contact_list" "test" {
+  contact_list_name = %[1]q
+  description       = %[2]q
+
+  topic {
+    default_subscription_status = "OPT_IN"
+    display_name                = "topic1"
+    topic_name                  = "topic1"
+  }
+}
+`, rName, description)
+}
@@ -0,0 +1,141 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sesv2/finder"
+)
+
+func resourceAwsSesV2DedicatedIpPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSesV2DedicatedIpPoolCreate,
+		Read:   resourceAwsSesV2DedicatedIpPoolRead,
+		Update: resourceAwsSesV2DedicatedIpPoolUpdate,
+		Delete: resourceAwsSesV2DedicatedIpPoolDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pool_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsSesV2DedicatedIpPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	poolName := d.Get("pool_name").(string)
+
+	input := &sesv2.CreateDedicatedIpPoolInput{
+		PoolName: aws.String(poolName),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().Sesv2Tags()
+	}
+
+	log.Printf("[DEBUG] Creating SES dedicated IP pool: %s", input)
+	_, err := conn.CreateDedicatedIpPool(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SES dedicated IP pool (%s): %w", poolName, err)
+	}
+
+	d.SetId(poolName)
+
+	return resourceAwsSesV2DedicatedIpPoolRead(d, meta)
+}
+
+func resourceAwsSesV2DedicatedIpPoolRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	poolName, err := finder.DedicatedIpPoolByName(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading SES dedicated IP pool (%s): %w", d.Id(), err)
+	}
+
+	if poolName == "" {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading SES dedicated IP pool (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] SES dedicated IP pool (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "ses",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("dedicated-ip-pool/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("pool_name", poolName)
+
+	tags, err := keyvaluetags.Sesv2ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for SES dedicated IP pool (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSesV2DedicatedIpPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.Sesv2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating SES dedicated IP pool (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSesV2DedicatedIpPoolRead(d, meta)
+}
+
+func resourceAwsSesV2DedicatedIpPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	log.Printf("[DEBUG] Deleting SES dedicated IP pool: %s", d.Id())
+	_, err := conn.DeleteDedicatedIpPool(&sesv2.DeleteDedicatedIpPoolInput{
+		PoolName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SES dedicated IP pool (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
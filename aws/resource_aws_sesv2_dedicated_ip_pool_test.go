@@ -0,0 +1,185 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sesv2/finder"
+)
+
+func TestAccAWSSESV2DedicatedIpPool_basic(t *testing.T) {
+	resourceName := "aws_sesv2_dedicated_ip_pool.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSES(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSESV2DedicatedIpPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2DedicatedIpPoolConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESV2DedicatedIpPoolExists(resourceName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "ses", fmt.Sprintf("dedicated-ip-pool/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "pool_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSESV2DedicatedIpPool_disappears(t *testing.T) {
+	resourceName := "aws_sesv2_dedicated_ip_pool.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSES(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSESV2DedicatedIpPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2DedicatedIpPoolConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESV2DedicatedIpPoolExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSesV2DedicatedIpPool(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSESV2DedicatedIpPool_Tags(t *testing.T) {
+	resourceName := "aws_sesv2_dedicated_ip_pool.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSES(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSESV2DedicatedIpPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2DedicatedIpPoolConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESV2DedicatedIpPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSESV2DedicatedIpPoolConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESV2DedicatedIpPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSSESV2DedicatedIpPoolConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESV2DedicatedIpPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSESV2DedicatedIpPoolDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_dedicated_ip_pool" {
+			continue
+		}
+
+		poolName, err := finder.DedicatedIpPoolByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if poolName != "" {
+			return fmt.Errorf("SES dedicated IP pool (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSESV2DedicatedIpPoolExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SES dedicated IP pool ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+		poolName, err := finder.DedicatedIpPoolByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if poolName == "" {
+			return fmt.Errorf("SES dedicated IP pool (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSSESV2DedicatedIpPoolConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_dedicated_ip_pool" "test" {
+  pool_name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSSESV2DedicatedIpPoolConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_dedicated_ip_pool" "test" {
+  pool_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSSESV2DedicatedIpPoolConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_dedicated_ip_pool" "test" {
+  pool_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
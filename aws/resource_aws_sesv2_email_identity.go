@@ -0,0 +1,249 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsSesV2EmailIdentity() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSesV2EmailIdentityCreate,
+		Read:   resourceAwsSesV2EmailIdentityRead,
+		Update: resourceAwsSesV2EmailIdentityUpdate,
+		Delete: resourceAwsSesV2EmailIdentityDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dkim_signing_attributes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain_signing_private_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							RequiredWith: []string{"dkim_signing_attributes.0.domain_signing_selector"},
+							ValidateFunc: validation.StringLenBetween(1, 20480),
+						},
+						"domain_signing_selector": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							RequiredWith: []string{"dkim_signing_attributes.0.domain_signing_private_key"},
+							ValidateFunc: validation.StringLenBetween(1, 63),
+						},
+						"signing_attributes_origin": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tokens": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"email_identity": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"identity_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"verified_for_sending_status": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSesV2EmailIdentityCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	emailIdentity := d.Get("email_identity").(string)
+
+	input := &sesv2.CreateEmailIdentityInput{
+		EmailIdentity: aws.String(emailIdentity),
+	}
+
+	if v, ok := d.GetOk("dkim_signing_attributes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DkimSigningAttributes = expandSesV2DkimSigningAttributes(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().Sesv2Tags()
+	}
+
+	log.Printf("[DEBUG] Creating SES email identity: %s", input)
+	_, err := conn.CreateEmailIdentity(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SES email identity (%s): %w", emailIdentity, err)
+	}
+
+	d.SetId(emailIdentity)
+
+	return resourceAwsSesV2EmailIdentityRead(d, meta)
+}
+
+func resourceAwsSesV2EmailIdentityRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := conn.GetEmailIdentity(&sesv2.GetEmailIdentityInput{
+		EmailIdentity: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		log.Printf("[WARN] SES email identity (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SES email identity (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "ses",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("identity/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+
+	if err := d.Set("dkim_signing_attributes", flattenSesV2DkimAttributes(output.DkimAttributes, d.Get("dkim_signing_attributes").([]interface{}))); err != nil {
+		return fmt.Errorf("error setting dkim_signing_attributes: %w", err)
+	}
+
+	d.Set("email_identity", d.Id())
+	d.Set("identity_type", output.IdentityType)
+	d.Set("verified_for_sending_status", output.VerifiedForSendingStatus)
+
+	if err := d.Set("tags", keyvaluetags.Sesv2KeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSesV2EmailIdentityUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	if d.HasChanges("dkim_signing_attributes.0.domain_signing_private_key", "dkim_signing_attributes.0.domain_signing_selector") {
+		input := &sesv2.PutEmailIdentityDkimSigningAttributesInput{
+			EmailIdentity:           aws.String(d.Id()),
+			SigningAttributesOrigin: aws.String(sesv2.DkimSigningAttributesOriginAwsSes),
+		}
+
+		if v, ok := d.GetOk("dkim_signing_attributes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if signingAttributes := expandSesV2DkimSigningAttributes(v.([]interface{})[0].(map[string]interface{})); signingAttributes != nil {
+				input.SigningAttributes = signingAttributes
+				input.SigningAttributesOrigin = aws.String(sesv2.DkimSigningAttributesOriginExternal)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating SES email identity DKIM signing attributes: %s", input)
+		if _, err := conn.PutEmailIdentityDkimSigningAttributes(input); err != nil {
+			return fmt.Errorf("error updating SES email identity (%s) DKIM signing attributes: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.Sesv2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating SES email identity (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSesV2EmailIdentityRead(d, meta)
+}
+
+func resourceAwsSesV2EmailIdentityDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	log.Printf("[DEBUG] Deleting SES email identity: %s", d.Id())
+	_, err := conn.DeleteEmailIdentity(&sesv2.DeleteEmailIdentityInput{
+		EmailIdentity: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SES email identity (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// expandSesV2DkimSigningAttributes returns nil unless Bring Your Own DKIM (BYODKIM) values are configured.
+func expandSesV2DkimSigningAttributes(tfMap map[string]interface{}) *sesv2.DkimSigningAttributes {
+	if tfMap == nil {
+		return nil
+	}
+
+	privateKey, _ := tfMap["domain_signing_private_key"].(string)
+	selector, _ := tfMap["domain_signing_selector"].(string)
+
+	if privateKey == "" || selector == "" {
+		return nil
+	}
+
+	return &sesv2.DkimSigningAttributes{
+		DomainSigningPrivateKey: aws.String(privateKey),
+		DomainSigningSelector:   aws.String(selector),
+	}
+}
+
+// flattenSesV2DkimAttributes preserves the configured private key and selector,
+// which the API never returns.
+func flattenSesV2DkimAttributes(apiObject *sesv2.DkimAttributes, configured []interface{}) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"signing_attributes_origin": aws.StringValue(apiObject.SigningAttributesOrigin),
+		"status":                    aws.StringValue(apiObject.Status),
+		"tokens":                    aws.StringValueSlice(apiObject.Tokens),
+	}
+
+	if len(configured) > 0 && configured[0] != nil {
+		old := configured[0].(map[string]interface{})
+
+		tfMap["domain_signing_private_key"] = old["domain_signing_private_key"]
+		tfMap["domain_signing_selector"] = old["domain_signing_selector"]
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,219 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSSESV2EmailIdentity_basic(t *testing.T) {
+	resourceName := "aws_sesv2_email_identity.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	email := fmt.Sprintf("%s@example.com", rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSES(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSESV2EmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2EmailIdentityConfig(email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESV2EmailIdentityExists(resourceName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "ses", fmt.Sprintf("identity/%s", email)),
+					resource.TestCheckResourceAttr(resourceName, "email_identity", email),
+					resource.TestCheckResourceAttr(resourceName, "identity_type", sesv2.IdentityTypeEmailAddress),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "verified_for_sending_status", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSESV2EmailIdentity_disappears(t *testing.T) {
+	resourceName := "aws_sesv2_email_identity.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	email := fmt.Sprintf("%s@example.com", rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSES(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSESV2EmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2EmailIdentityConfig(email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESV2EmailIdentityExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSesV2EmailIdentity(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSESV2EmailIdentity_Domain(t *testing.T) {
+	resourceName := "aws_sesv2_email_identity.test"
+	domain := fmt.Sprintf("%s.terraformtesting.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSES(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSESV2EmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2EmailIdentityConfig(domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESV2EmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.signing_attributes_origin", sesv2.DkimSigningAttributesOriginAwsSes),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.tokens.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "email_identity", domain),
+					resource.TestCheckResourceAttr(resourceName, "identity_type", sesv2.IdentityTypeDomain),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSESV2EmailIdentity_Tags(t *testing.T) {
+	resourceName := "aws_sesv2_email_identity.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	email := fmt.Sprintf("%s@example.com", rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSES(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSESV2EmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2EmailIdentityConfigTags1(email, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESV2EmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSESV2EmailIdentityConfigTags2(email, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESV2EmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSSESV2EmailIdentityConfigTags1(email, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESV2EmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSESV2EmailIdentityDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_email_identity" {
+			continue
+		}
+
+		_, err := conn.GetEmailIdentity(&sesv2.GetEmailIdentityInput{
+			EmailIdentity: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SES email identity (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSESV2EmailIdentityExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SES email identity ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+		_, err := conn.GetEmailIdentity(&sesv2.GetEmailIdentityInput{
+			EmailIdentity: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSSESV2EmailIdentityConfig(emailIdentity string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_email_identity" "test" {
+  email_identity = %[1]q
+}
+`, emailIdentity)
+}
+
+func testAccAWSSESV2EmailIdentityConfigTags1(emailIdentity, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_email_identity" "test" {
+  email_identity = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, emailIdentity, tagKey1, tagValue1)
+}
+
+func testAccAWSSESV2EmailIdentityConfigTags2(emailIdentity, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_email_identity" "test" {
+  email_identity = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, emailIdentity, tagKey1, tagValue1, tagKey2, tagValue2)
+}
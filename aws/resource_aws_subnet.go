@@ -20,7 +20,7 @@ func resourceAwsSubnet() *schema.Resource {
 		Update: resourceAwsSubnetUpdate,
 		Delete: resourceAwsSubnetDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByARN("ec2", arnResourceIDFunc("subnet", "/"), schema.ImportStatePassthrough),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -30,6 +30,12 @@ func resourceAwsTransferServer() *schema.Resource {
 				Computed: true,
 			},
 
+			"certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+
 			"endpoint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -128,6 +134,29 @@ func resourceAwsTransferServer() *schema.Resource {
 				Default:  false,
 			},
 
+			"protocols": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MinItems: 1,
+				MaxItems: 3,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(transfer.Protocol_Values(), false),
+				},
+			},
+
+			"security_policy_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "TransferSecurityPolicy-2018-11",
+				ValidateFunc: validation.StringInSlice([]string{
+					"TransferSecurityPolicy-2018-11",
+					"TransferSecurityPolicy-2020-06",
+					"TransferSecurityPolicy-FIPS-2020-06",
+				}, false),
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -183,6 +212,18 @@ func resourceAwsTransferServerCreate(d *schema.ResourceData, meta interface{}) e
 		createOpts.HostKey = aws.String(attr.(string))
 	}
 
+	if attr, ok := d.GetOk("certificate"); ok {
+		createOpts.Certificate = aws.String(attr.(string))
+	}
+
+	if attr, ok := d.GetOk("protocols"); ok && attr.(*schema.Set).Len() > 0 {
+		createOpts.Protocols = expandStringSet(attr.(*schema.Set))
+	}
+
+	if attr, ok := d.GetOk("security_policy_name"); ok {
+		createOpts.SecurityPolicyName = aws.String(attr.(string))
+	}
+
 	log.Printf("[DEBUG] Create Transfer Server Option: %#v", createOpts)
 
 	resp, err := conn.CreateServer(createOpts)
@@ -276,6 +317,7 @@ func resourceAwsTransferServerRead(d *schema.ResourceData, meta interface{}) err
 	endpoint := meta.(*AWSClient).RegionalHostname(fmt.Sprintf("%s.server.transfer", d.Id()))
 
 	d.Set("arn", resp.Server.Arn)
+	d.Set("certificate", resp.Server.Certificate)
 	d.Set("endpoint", endpoint)
 	d.Set("invocation_role", "")
 	d.Set("url", "")
@@ -288,6 +330,10 @@ func resourceAwsTransferServerRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("identity_provider_type", resp.Server.IdentityProviderType)
 	d.Set("logging_role", resp.Server.LoggingRole)
 	d.Set("host_key_fingerprint", resp.Server.HostKeyFingerprint)
+	if err := d.Set("protocols", flattenStringSet(resp.Server.Protocols)); err != nil {
+		return fmt.Errorf("Error setting protocols: %s", err)
+	}
+	d.Set("security_policy_name", resp.Server.SecurityPolicyName)
 
 	if err := d.Set("tags", keyvaluetags.TransferKeyValueTags(resp.Server.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("Error setting tags: %s", err)
@@ -347,6 +393,21 @@ func resourceAwsTransferServerUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("certificate") {
+		updateFlag = true
+		updateOpts.Certificate = aws.String(d.Get("certificate").(string))
+	}
+
+	if d.HasChange("protocols") {
+		updateFlag = true
+		updateOpts.Protocols = expandStringSet(d.Get("protocols").(*schema.Set))
+	}
+
+	if d.HasChange("security_policy_name") {
+		updateFlag = true
+		updateOpts.SecurityPolicyName = aws.String(d.Get("security_policy_name").(string))
+	}
+
 	if updateFlag {
 		if stopFlag {
 			if err := stopAndWaitForTransferServer(d.Id(), conn, d.Timeout(schema.TimeoutUpdate)); err != nil {
@@ -24,7 +24,7 @@ func resourceAwsVpc() *schema.Resource {
 		Update: resourceAwsVpcUpdate,
 		Delete: resourceAwsVpcDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceAwsVpcInstanceImport,
+			State: importStateByARN("ec2", arnResourceIDFunc("vpc", "/"), resourceAwsVpcInstanceImport),
 		},
 		CustomizeDiff: resourceAwsVpcCustomizeDiff,
 
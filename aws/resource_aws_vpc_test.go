@@ -134,6 +134,12 @@ func TestAccAWSVpc_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccARNImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	serviceCatalogAcceptLanguageEnglish  = "en"
+	serviceCatalogAcceptLanguageJapanese = "jp"
+	serviceCatalogAcceptLanguageChinese  = "zh"
+)
+
+func serviceCatalogAcceptLanguageValues() []string {
+	return []string{
+		serviceCatalogAcceptLanguageEnglish,
+		serviceCatalogAcceptLanguageJapanese,
+		serviceCatalogAcceptLanguageChinese,
+	}
+}
+
+func serviceCatalogAcceptLanguageSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      serviceCatalogAcceptLanguageEnglish,
+		ValidateFunc: validation.StringInSlice(serviceCatalogAcceptLanguageValues(), false),
+	}
+}
+
+// serviceCatalogAcceptLanguageForceNewSchema is used by resources that cannot be updated.
+func serviceCatalogAcceptLanguageForceNewSchema() *schema.Schema {
+	s := serviceCatalogAcceptLanguageSchema()
+	s.ForceNew = true
+
+	return s
+}
+
+// Keys of the provisioning artifact information map used when creating products and provisioning artifacts.
+const (
+	serviceCatalogProvisioningArtifactInfoKeyLoadTemplateFromURL  = "LoadTemplateFromURL"
+	serviceCatalogProvisioningArtifactInfoKeyImportFromPhysicalID = "ImportFromPhysicalId"
+)
+
+func expandServiceCatalogProvisioningArtifactInfo(templateURL, templatePhysicalID string) map[string]*string {
+	info := map[string]*string{}
+
+	if templateURL != "" {
+		info[serviceCatalogProvisioningArtifactInfoKeyLoadTemplateFromURL] = &templateURL
+	}
+
+	if templatePhysicalID != "" {
+		info[serviceCatalogProvisioningArtifactInfoKeyImportFromPhysicalID] = &templatePhysicalID
+	}
+
+	return info
+}
@@ -1012,6 +1012,45 @@ func validateIAMPolicyJson(v interface{}, k string) (ws []string, errors []error
 	return
 }
 
+// validateIAMIdentityPolicyDocument validates an identity-based IAM policy
+// document against the IAM policy grammar without calling the AWS API.
+func validateIAMIdentityPolicyDocument(v interface{}, k string) (ws []string, errors []error) {
+	ws, errors = validateIAMPolicyJson(v, k)
+	if len(errors) > 0 {
+		return
+	}
+
+	errors = validateIAMPolicyDocumentGrammar(v.(string), k, iamPolicyTypeIdentity)
+	return
+}
+
+// validateIAMResourcePolicyDocument validates a resource-based IAM policy or
+// IAM role trust policy document against the IAM policy grammar without
+// calling the AWS API.
+func validateIAMResourcePolicyDocument(v interface{}, k string) (ws []string, errors []error) {
+	ws, errors = validation.StringIsJSON(v, k)
+	if len(errors) > 0 {
+		return
+	}
+
+	errors = validateIAMPolicyDocumentGrammar(v.(string), k, iamPolicyTypeResource)
+	return
+}
+
+func validateIAMPolicyDocumentGrammar(policy, k, policyType string) []error {
+	doc, err := iamPolicyDecodeDocument(policy)
+	if err != nil {
+		return []error{fmt.Errorf("%q contains an invalid policy: %w", k, err)}
+	}
+
+	var errors []error
+	for _, err := range doc.Validate(policyType) {
+		errors = append(errors, fmt.Errorf("%q contains an invalid policy: %w", k, err))
+	}
+
+	return errors
+}
+
 func validateStringIsJsonOrYaml(v interface{}, k string) (ws []string, errors []error) {
 	if looksLikeJsonString(v) {
 		if _, err := structure.NormalizeJsonString(v); err != nil {
@@ -904,6 +904,182 @@ func TestValidateIAMPolicyJsonString(t *testing.T) {
 	}
 }
 
+func TestValidateIAMIdentityPolicyDocument(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Value    string
+		ErrCount int
+	}{
+		{
+			Name: "valid",
+			Value: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "ReadOnly",
+      "Effect": "Allow",
+      "Action": ["s3:GetObject", "s3:List*"],
+      "Resource": ["arn:aws:s3:::example", "arn:aws:s3:::example/*"],
+      "Condition": {
+        "Bool": {"aws:SecureTransport": true},
+        "ForAnyValue:StringLikeIfExists": {"aws:PrincipalTag/team": ["a", "b"]}
+      }
+    },
+    {
+      "Effect": "Deny",
+      "NotAction": "iam:*",
+      "NotResource": "*"
+    }
+  ]
+}`,
+		},
+		{
+			Name:  "single statement",
+			Value: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"*","Resource":"*"}}`,
+		},
+		{
+			Name:  "partial ARN with wildcard",
+			Value: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:*","Resource":"arn:aws:ec2:*"}]}`,
+		},
+		{
+			Name:     "invalid JSON",
+			Value:    `{"Version":`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "missing Statement",
+			Value:    `{"Version":"2012-10-17"}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "unsupported element",
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Actions":"*","Resource":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "unsupported Version",
+			Value:    `{"Version":"2020-01-01","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "invalid Effect",
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"allow","Action":"*","Resource":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "missing Action",
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Resource":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "invalid Action",
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"GetObject","Resource":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "missing Resource",
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "invalid Resource",
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":["arn:aws:s3:::example","example"]}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "Principal",
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"*","Resource":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "unknown condition operator",
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringEqual":{"aws:username":"example"}}}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "multiple statements",
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Permit","Action":"*","Resource":"*"},{"Effect":"Allow","Action":"*","NotAction":"s3:*","Resource":"bucket"}]}`,
+			ErrCount: 3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, errors := validateIAMIdentityPolicyDocument(testCase.Value, "policy")
+
+			if len(errors) != testCase.ErrCount {
+				t.Fatalf("got %d errors, expected %d: %v", len(errors), testCase.ErrCount, errors)
+			}
+		})
+	}
+}
+
+func TestValidateIAMResourcePolicyDocument(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Value    string
+		ErrCount int
+	}{
+		{
+			Name: "valid",
+			Value: `
+{
+  "Version": "2012-10-17",
+  "Id": "example",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": ["arn:aws:iam::123456789012:root", "123456789012"]},
+      "Action": "sqs:SendMessage",
+      "Resource": "arn:aws:sqs:us-west-2:123456789012:example"
+    },
+    {
+      "Effect": "Deny",
+      "NotPrincipal": {"Service": "sns.amazonaws.com"},
+      "Action": "sqs:*",
+      "Resource": "*",
+      "Condition": {"NumericGreaterThan": {"aws:MultiFactorAuthAge": 3600}}
+    }
+  ]
+}`,
+		},
+		{
+			Name:  "trust policy",
+			Value: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+		},
+		{
+			Name:     "missing Principal",
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "invalid Principal",
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"arn:aws:iam::123456789012:root","Action":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "unsupported principal type",
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"User":"example"},"Action":"*"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "NotPrincipal with Allow",
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","NotPrincipal":{"AWS":"123456789012"},"Action":"*"}]}`,
+			ErrCount: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, errors := validateIAMResourcePolicyDocument(testCase.Value, "policy")
+
+			if len(errors) != testCase.ErrCount {
+				t.Fatalf("got %d errors, expected %d: %v", len(errors), testCase.ErrCount, errors)
+			}
+		})
+	}
+}
+
 func TestValidateStringIsJsonOrYaml(t *testing.T) {
 	type testCases struct {
 		Value    string